@@ -0,0 +1,28 @@
+package autherr
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrUnauthorized indicates the credentials were rejected as invalid or
+// expired, such as an HTTP 401 or an expired AWS session token.
+var ErrUnauthorized = errors.New("credentials rejected or expired")
+
+// ErrForbidden indicates the credentials were accepted but lack permission for
+// the operation, such as an HTTP 403. Vault also reports invalid or expired
+// tokens this way.
+var ErrForbidden = errors.New("credentials not permitted")
+
+// FromStatus returns the sentinel for an HTTP status code: ErrUnauthorized for
+// 401, ErrForbidden for 403, and nil otherwise.
+func FromStatus(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	default:
+		return nil
+	}
+}
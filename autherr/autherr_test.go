@@ -0,0 +1,26 @@
+package autherr
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestFromStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{status: http.StatusUnauthorized, want: ErrUnauthorized},
+		{status: http.StatusForbidden, want: ErrForbidden},
+		{status: http.StatusNotFound, want: nil},
+		{status: http.StatusOK, want: nil},
+	}
+
+	for _, tt := range tests {
+		if got := FromStatus(tt.status); got != tt.want {
+			t.Fatalf("status %d: got %v want %v", tt.status, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package autherr defines credential failure sentinels shared by the
+// cloudflare, vault, and awsx clients, so re-authentication logic can use a
+// single errors.Is check across integrations.
+package autherr
@@ -1,2 +1,5 @@
 // Package awsx provides AWS client factory and STS helper utilities.
+//
+// Helpers for other AWS services live in sub-packages (for example awsx/ssmx)
+// that take a *Factory, so binaries only link the SDK clients they import.
 package awsx
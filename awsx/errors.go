@@ -0,0 +1,57 @@
+package awsx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/d-padmanabhan/platform-core-go/autherr"
+)
+
+// unauthorizedCodes are AWS error codes for credentials that are expired or
+// not recognized.
+var unauthorizedCodes = map[string]bool{
+	"ExpiredToken":                true,
+	"ExpiredTokenException":       true,
+	"RequestExpired":              true,
+	"InvalidClientTokenId":        true,
+	"UnrecognizedClientException": true,
+}
+
+// expiredTokenCodes are AWS error codes for temporary credentials that have
+// expired and may succeed after the configuration is reloaded.
+var expiredTokenCodes = map[string]bool{
+	"ExpiredToken":          true,
+	"ExpiredTokenException": true,
+}
+
+// forbiddenCodes are AWS error codes for valid credentials lacking permission.
+var forbiddenCodes = map[string]bool{
+	"AccessDenied":          true,
+	"AccessDeniedException": true,
+}
+
+// WrapAuthError wraps err with autherr.ErrUnauthorized when its AWS error code
+// marks expired or unrecognized credentials, or with autherr.ErrForbidden when
+// access was denied. Other errors, including nil, are returned unchanged.
+func WrapAuthError(err error) error {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	switch code := apiErr.ErrorCode(); {
+	case unauthorizedCodes[code]:
+		return fmt.Errorf("%w: %w", autherr.ErrUnauthorized, err)
+	case forbiddenCodes[code]:
+		return fmt.Errorf("%w: %w", autherr.ErrForbidden, err)
+	default:
+		return err
+	}
+}
+
+// isExpiredToken reports whether err carries an expired-token AWS error code.
+func isExpiredToken(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && expiredTokenCodes[apiErr.ErrorCode()]
+}
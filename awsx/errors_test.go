@@ -0,0 +1,44 @@
+package awsx
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+
+	"github.com/d-padmanabhan/platform-core-go/autherr"
+)
+
+func TestWrapAuthError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code string
+		want error
+	}{
+		{code: "ExpiredToken", want: autherr.ErrUnauthorized},
+		{code: "InvalidClientTokenId", want: autherr.ErrUnauthorized},
+		{code: "AccessDenied", want: autherr.ErrForbidden},
+		{code: "ThrottlingException", want: nil},
+	}
+
+	for _, tt := range tests {
+		apiErr := &smithy.GenericAPIError{Code: tt.code, Message: "boom"}
+		err := WrapAuthError(fmt.Errorf("assume role: %w", apiErr))
+
+		var gotAPIErr smithy.APIError
+		if !errors.As(err, &gotAPIErr) {
+			t.Fatalf("%s: expected the AWS error to stay wrapped, got %v", tt.code, err)
+		}
+		for _, sentinel := range []error{autherr.ErrUnauthorized, autherr.ErrForbidden} {
+			if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+				t.Fatalf("%s: errors.Is(%v) = %v", tt.code, sentinel, got)
+			}
+		}
+	}
+
+	if err := WrapAuthError(nil); err != nil {
+		t.Fatalf("expected nil for nil error, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -28,7 +29,11 @@ var (
 
 // Factory stores shared AWS configuration for helper operations.
 type Factory struct {
-	cfg aws.Config
+	mu         sync.RWMutex
+	cfg        aws.Config
+	generation int
+	load       func(context.Context) (aws.Config, error)
+	autoReload bool
 }
 
 // ValidateRegion verifies a region against the platform allowlist.
@@ -56,15 +61,87 @@ func NewFactory(
 	}
 	baseOptions = append(baseOptions, loadOptions...)
 
-	cfg, err := config.LoadDefaultConfig(ctx, baseOptions...)
+	load := func(ctx context.Context) (aws.Config, error) {
+		cfg, err := config.LoadDefaultConfig(ctx, baseOptions...)
+		if err != nil {
+			return aws.Config{}, fmt.Errorf("load AWS config: %w", err)
+		}
+		return cfg, nil
+	}
+	cfg, err := load(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("load AWS config: %w", err)
+		return nil, err
 	}
 
-	return &Factory{cfg: cfg}, nil
+	return &Factory{cfg: cfg, load: load}, nil
+}
+
+// WithAutoReloadOnExpiry makes factory operations that fail with an
+// ExpiredToken or ExpiredTokenException error reload the AWS config from the
+// original load options once and retry, so long-running processes survive
+// credential rotation. It returns f for chaining and must be called before f
+// is shared between goroutines.
+func (f *Factory) WithAutoReloadOnExpiry() *Factory {
+	f.autoReload = true
+	return f
 }
 
 // Region returns the configured AWS region.
 func (f *Factory) Region() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
 	return f.cfg.Region
 }
+
+// Config returns a copy of the shared AWS configuration for building service
+// clients, such as in the awsx service sub-packages.
+func (f *Factory) Config() aws.Config {
+	cfg, _ := f.config()
+	return cfg
+}
+
+// config returns a copy of the shared AWS configuration and its generation,
+// which counts reloads.
+func (f *Factory) config() (aws.Config, int) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.cfg.Copy(), f.generation
+}
+
+// withConfig runs op with the shared AWS configuration. With
+// WithAutoReloadOnExpiry, an expired-token failure reloads the configuration
+// and runs op once more.
+func (f *Factory) withConfig(ctx context.Context, op func(cfg aws.Config) error) error {
+	cfg, generation := f.config()
+	err := op(cfg)
+	if err == nil || !f.autoReload || !isExpiredToken(err) {
+		return err
+	}
+
+	if reloadErr := f.reload(ctx, generation); reloadErr != nil {
+		return fmt.Errorf("%w (reload after expired token: %w)", err, reloadErr)
+	}
+	cfg, _ = f.config()
+	return op(cfg)
+}
+
+// reload replaces the shared AWS configuration unless another caller already
+// reloaded it since generation was read.
+func (f *Factory) reload(ctx context.Context, generation int) error {
+	if f.load == nil {
+		return errors.New("factory has no load options to reload from")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.generation != generation {
+		return nil
+	}
+	cfg, err := f.load(ctx)
+	if err != nil {
+		return err
+	}
+	f.cfg = cfg
+	f.generation++
+	return nil
+}
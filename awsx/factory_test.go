@@ -3,7 +3,14 @@ package awsx
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
 func TestValidateRegion(t *testing.T) {
@@ -27,3 +34,71 @@ func TestNewFactory_InvalidRegion(t *testing.T) {
 		t.Fatalf("expected ErrInvalidRegion, got: %v", err)
 	}
 }
+
+func TestFactoryAutoReloadOnExpiry(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		if strings.Contains(r.Header.Get("Authorization"), "Credential=AKIDOLD/") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error><Type>Sender</Type><Code>ExpiredToken</Code><Message>token expired</Message></Error>
+  <RequestId>req-1</RequestId>
+</ErrorResponse>`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult><Account>111111111111</Account></GetCallerIdentityResult>
+</GetCallerIdentityResponse>`)
+	}))
+	defer server.Close()
+
+	configWithKey := func(accessKeyID string) aws.Config {
+		return aws.Config{
+			Region:       "us-east-1",
+			BaseEndpoint: aws.String(server.URL),
+			HTTPClient:   server.Client(),
+			Credentials: staticCredentials(aws.Credentials{
+				AccessKeyID:     accessKeyID,
+				SecretAccessKey: "secret",
+			}),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		autoReload  bool
+		wantErr     bool
+		wantReloads int32
+	}{
+		{name: "reloads expired credentials", autoReload: true, wantReloads: 1},
+		{name: "fails without auto reload", autoReload: false, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var reloads atomic.Int32
+		factory := &Factory{
+			cfg: configWithKey("AKIDOLD"),
+			load: func(context.Context) (aws.Config, error) {
+				reloads.Add(1)
+				return configWithKey("AKIDNEW"), nil
+			},
+		}
+		if tt.autoReload {
+			factory.WithAutoReloadOnExpiry()
+		}
+
+		accountID, err := factory.AccountID(context.Background())
+		if tt.wantErr {
+			if !isExpiredToken(err) {
+				t.Fatalf("%s: expected expired token error, got: %v", tt.name, err)
+			}
+		} else if err != nil || accountID != "111111111111" {
+			t.Fatalf("%s: unexpected account ID %q, err: %v", tt.name, accountID, err)
+		}
+		if got := reloads.Load(); got != tt.wantReloads {
+			t.Fatalf("%s: expected %d reloads, got %d", tt.name, tt.wantReloads, got)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package iamx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/d-padmanabhan/platform-core-go/awsx"
+)
+
+// AccountSummary identifies the AWS account behind the configured credentials.
+type AccountSummary struct {
+	AccountID string
+	// Alias is empty when the account has no alias configured.
+	Alias string
+	ARN   string
+}
+
+// Client provides IAM account helpers.
+type Client struct {
+	iam *iam.Client
+	sts *sts.Client
+}
+
+// New creates an IAM helper client from the shared factory configuration.
+func New(factory *awsx.Factory) *Client {
+	cfg := factory.Config()
+	return &Client{
+		iam: iam.NewFromConfig(cfg),
+		sts: sts.NewFromConfig(cfg),
+	}
+}
+
+// AccountSummary returns the caller's account ID, ARN, and account alias.
+func (c *Client) AccountSummary(ctx context.Context) (*AccountSummary, error) {
+	identity, err := c.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, awsx.WrapAuthError(fmt.Errorf("get caller identity: %w", err))
+	}
+	accountID := strings.TrimSpace(aws.ToString(identity.Account))
+	if accountID == "" {
+		return nil, errors.New("get caller identity returned empty account ID")
+	}
+
+	aliases, err := c.iam.ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+	if err != nil {
+		return nil, awsx.WrapAuthError(fmt.Errorf("list account aliases: %w", err))
+	}
+
+	summary := &AccountSummary{
+		AccountID: accountID,
+		ARN:       aws.ToString(identity.Arn),
+	}
+	// An account can have at most one alias.
+	if len(aliases.AccountAliases) > 0 {
+		summary.Alias = aliases.AccountAliases[0]
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,84 @@
+package iamx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/d-padmanabhan/platform-core-go/awsx"
+)
+
+func TestAccountSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		aliasXML  string
+		wantAlias string
+	}{
+		{name: "with alias", aliasXML: "<member>acme-prod</member>", wantAlias: "acme-prod"},
+		{name: "without alias", aliasXML: "", wantAlias: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				w.Header().Set("Content-Type", "text/xml")
+				switch r.Form.Get("Action") {
+				case "GetCallerIdentity":
+					_, _ = w.Write([]byte(`<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/ops</Arn>
+    <UserId>AIDA</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>`))
+				case "ListAccountAliases":
+					_, _ = w.Write([]byte(`<ListAccountAliasesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListAccountAliasesResult>
+    <IsTruncated>false</IsTruncated>
+    <AccountAliases>` + tt.aliasXML + `</AccountAliases>
+  </ListAccountAliasesResult>
+</ListAccountAliasesResponse>`))
+				default:
+					http.Error(w, "unexpected action", http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			factory, err := awsx.NewFactory(
+				context.Background(),
+				"us-east-1",
+				config.WithBaseEndpoint(server.URL),
+				config.WithCredentialsProvider(aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+					return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+				})),
+			)
+			if err != nil {
+				t.Fatalf("new factory: %v", err)
+			}
+
+			summary, err := New(factory).AccountSummary(context.Background())
+			if err != nil {
+				t.Fatalf("account summary: %v", err)
+			}
+			if summary.AccountID != "123456789012" || summary.ARN != "arn:aws:iam::123456789012:user/ops" {
+				t.Fatalf("unexpected account summary: %#v", summary)
+			}
+			if summary.Alias != tt.wantAlias {
+				t.Fatalf("unexpected alias: got=%q want=%q", summary.Alias, tt.wantAlias)
+			}
+		})
+	}
+}
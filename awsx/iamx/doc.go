@@ -0,0 +1,2 @@
+// Package iamx provides AWS IAM account helpers built on awsx.Factory.
+package iamx
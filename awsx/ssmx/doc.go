@@ -0,0 +1,2 @@
+// Package ssmx provides AWS Systems Manager Parameter Store helpers built on awsx.Factory.
+package ssmx
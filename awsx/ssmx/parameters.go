@@ -0,0 +1,52 @@
+package ssmx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/d-padmanabhan/platform-core-go/awsx"
+)
+
+// ErrParameterNotFound indicates the requested SSM parameter does not exist.
+var ErrParameterNotFound = errors.New("ssm parameter not found")
+
+// Client provides SSM Parameter Store helpers.
+type Client struct {
+	ssm *ssm.Client
+}
+
+// New creates an SSM helper client from the shared factory configuration.
+func New(factory *awsx.Factory, optFns ...func(*ssm.Options)) *Client {
+	return &Client{ssm: ssm.NewFromConfig(factory.Config(), optFns...)}
+}
+
+// GetParameter returns the value of a parameter, decrypting SecureString values.
+func (c *Client) GetParameter(ctx context.Context, name string) (string, error) {
+	cleanName := strings.TrimSpace(name)
+	if cleanName == "" {
+		return "", errors.New("parameter name must not be empty")
+	}
+
+	output, err := c.ssm.GetParameter(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(cleanName),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		var notFound *types.ParameterNotFound
+		if errors.As(err, &notFound) {
+			return "", fmt.Errorf("%w: %s", ErrParameterNotFound, cleanName)
+		}
+		return "", awsx.WrapAuthError(fmt.Errorf("get parameter: %w", err))
+	}
+	if output.Parameter == nil || output.Parameter.Value == nil {
+		return "", fmt.Errorf("get parameter returned empty value: %s", cleanName)
+	}
+
+	return *output.Parameter.Value, nil
+}
@@ -0,0 +1,69 @@
+package ssmx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+
+	"github.com/d-padmanabhan/platform-core-go/awsx"
+)
+
+func TestGetParameter(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var input struct {
+			Name           string
+			WithDecryption bool
+		}
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		if input.Name != "/team/app/password" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ParameterNotFound","message":"not found"}`))
+			return
+		}
+		if !input.WithDecryption {
+			t.Fatalf("expected WithDecryption to be set")
+		}
+		_, _ = w.Write([]byte(`{"Parameter":{"Name":"/team/app/password","Type":"SecureString","Value":"s3cret","Version":1}}`))
+	}))
+	defer server.Close()
+
+	factory, err := awsx.NewFactory(
+		context.Background(),
+		"us-east-1",
+		config.WithBaseEndpoint(server.URL),
+		config.WithCredentialsProvider(aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		})),
+	)
+	if err != nil {
+		t.Fatalf("new factory: %v", err)
+	}
+
+	client := New(factory)
+
+	value, err := client.GetParameter(context.Background(), "/team/app/password")
+	if err != nil {
+		t.Fatalf("get parameter: %v", err)
+	}
+	if value != "s3cret" {
+		t.Fatalf("unexpected parameter value: %q", value)
+	}
+
+	_, err = client.GetParameter(context.Background(), "/team/app/missing")
+	if !errors.Is(err, ErrParameterNotFound) {
+		t.Fatalf("expected ErrParameterNotFound, got: %v", err)
+	}
+}
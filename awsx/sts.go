@@ -7,16 +7,21 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go-v2/service/sts/types"
 )
 
 // AccountID returns the caller account ID for the configured credentials.
 func (f *Factory) AccountID(ctx context.Context) (string, error) {
-	client := sts.NewFromConfig(f.cfg)
-	output, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	var output *sts.GetCallerIdentityOutput
+	err := f.withConfig(ctx, func(cfg aws.Config) error {
+		var err error
+		output, err = sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		return err
+	})
 	if err != nil {
-		return "", fmt.Errorf("get caller identity: %w", err)
+		return "", WrapAuthError(fmt.Errorf("get caller identity: %w", err))
 	}
 	if output.Account == nil || strings.TrimSpace(*output.Account) == "" {
 		return "", errors.New("get caller identity returned empty account ID")
@@ -31,6 +36,135 @@ func (f *Factory) AssumeRole(
 	roleARN string,
 	sessionName string,
 	duration time.Duration,
+) (*types.Credentials, error) {
+	var creds *types.Credentials
+	err := f.withConfig(ctx, func(cfg aws.Config) error {
+		var err error
+		creds, err = assumeRole(ctx, cfg, roleARN, sessionName, duration)
+		return err
+	})
+	return creds, err
+}
+
+// AssumeRoleWithMFA assumes an MFA-protected IAM role using the MFA device
+// serial number and the current six-digit token code.
+func (f *Factory) AssumeRoleWithMFA(
+	ctx context.Context,
+	roleARN string,
+	sessionName string,
+	mfaSerial string,
+	tokenCode string,
+	duration time.Duration,
+) (*types.Credentials, error) {
+	serial := strings.TrimSpace(mfaSerial)
+	if serial == "" {
+		return nil, errors.New("MFA serial number must not be empty")
+	}
+	code := strings.TrimSpace(tokenCode)
+	if !isMFATokenCode(code) {
+		return nil, errors.New("MFA token code must be 6 digits")
+	}
+
+	var creds *types.Credentials
+	err := f.withConfig(ctx, func(cfg aws.Config) error {
+		var err error
+		creds, err = assumeRole(ctx, cfg, roleARN, sessionName, duration, func(input *sts.AssumeRoleInput) {
+			input.SerialNumber = &serial
+			input.TokenCode = &code
+		})
+		return err
+	})
+	return creds, err
+}
+
+func isMFATokenCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// ChainStep describes one role assumption in an AssumeRoleChain.
+type ChainStep struct {
+	RoleARN     string
+	SessionName string
+	Duration    time.Duration
+}
+
+// AssumeRoleChain assumes each role in steps using the previous step's
+// credentials, starting from the factory credentials. Every hop runs under
+// ctx, so a single deadline bounds the whole chain. The returned provider
+// serves the final step's temporary credentials.
+func (f *Factory) AssumeRoleChain(ctx context.Context, steps []ChainStep) (aws.CredentialsProvider, error) {
+	if len(steps) == 0 {
+		return nil, errors.New("role chain must not be empty")
+	}
+	for i, step := range steps {
+		if strings.TrimSpace(step.RoleARN) == "" {
+			return nil, fmt.Errorf("role chain step %d: role ARN must not be empty", i)
+		}
+		if strings.TrimSpace(step.SessionName) == "" {
+			return nil, fmt.Errorf("role chain step %d: role session name must not be empty", i)
+		}
+	}
+
+	var provider aws.CredentialsProvider
+	err := f.withConfig(ctx, func(cfg aws.Config) error {
+		var err error
+		provider, err = assumeRoleChain(ctx, cfg, steps)
+		return err
+	})
+	return provider, err
+}
+
+// assumeRoleChain runs the role assumptions of AssumeRoleChain starting from
+// cfg's credentials.
+func assumeRoleChain(ctx context.Context, cfg aws.Config, steps []ChainStep) (aws.CredentialsProvider, error) {
+	var creds aws.Credentials
+	for i, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("role chain step %d: %w", i, err)
+		}
+
+		output, err := assumeRole(ctx, cfg, step.RoleARN, step.SessionName, step.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("role chain step %d (%s): %w", i, step.RoleARN, err)
+		}
+
+		creds = aws.Credentials{
+			AccessKeyID:     aws.ToString(output.AccessKeyId),
+			SecretAccessKey: aws.ToString(output.SecretAccessKey),
+			SessionToken:    aws.ToString(output.SessionToken),
+			Source:          "AssumeRoleChain",
+		}
+		if output.Expiration != nil {
+			creds.CanExpire = true
+			creds.Expires = *output.Expiration
+		}
+		cfg.Credentials = staticCredentials(creds)
+	}
+
+	return staticCredentials(creds), nil
+}
+
+func staticCredentials(creds aws.Credentials) aws.CredentialsProvider {
+	return aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+		return creds, nil
+	})
+}
+
+func assumeRole(
+	ctx context.Context,
+	cfg aws.Config,
+	roleARN string,
+	sessionName string,
+	duration time.Duration,
+	optFns ...func(*sts.AssumeRoleInput),
 ) (*types.Credentials, error) {
 	if strings.TrimSpace(roleARN) == "" {
 		return nil, errors.New("role ARN must not be empty")
@@ -47,11 +181,14 @@ func (f *Factory) AssumeRole(
 		seconds := int32(duration.Seconds())
 		input.DurationSeconds = &seconds
 	}
+	for _, fn := range optFns {
+		fn(input)
+	}
 
-	client := sts.NewFromConfig(f.cfg)
+	client := sts.NewFromConfig(cfg)
 	output, err := client.AssumeRole(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("assume role: %w", err)
+		return nil, WrapAuthError(fmt.Errorf("assume role: %w", err))
 	}
 	if output.Credentials == nil {
 		return nil, errors.New("assume role returned empty credentials")
@@ -0,0 +1,149 @@
+package awsx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestAssumeRoleChain_Validation(t *testing.T) {
+	t.Parallel()
+
+	factory := &Factory{cfg: aws.Config{Region: "us-east-1"}}
+
+	if _, err := factory.AssumeRoleChain(context.Background(), nil); err == nil {
+		t.Fatalf("expected empty chain validation error")
+	}
+
+	_, err := factory.AssumeRoleChain(context.Background(), []ChainStep{
+		{RoleARN: "arn:aws:iam::111111111111:role/a", SessionName: "a"},
+		{RoleARN: "arn:aws:iam::222222222222:role/b"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "step 1") {
+		t.Fatalf("expected step 1 session name validation error, got: %v", err)
+	}
+}
+
+func TestAssumeRoleChain_UsesPreviousCredentials(t *testing.T) {
+	t.Parallel()
+
+	var signedWith []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		start := strings.Index(auth, "Credential=") + len("Credential=")
+		signedWith = append(signedWith, strings.SplitN(auth[start:], "/", 2)[0])
+
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AKIDSTEP%d</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>session</SessionToken>
+      <Expiration>2030-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`, len(signedWith))
+	}))
+	defer server.Close()
+
+	factory := &Factory{cfg: aws.Config{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		HTTPClient:   server.Client(),
+		Credentials: staticCredentials(aws.Credentials{
+			AccessKeyID:     "AKIDBASE",
+			SecretAccessKey: "secret",
+		}),
+	}}
+
+	provider, err := factory.AssumeRoleChain(context.Background(), []ChainStep{
+		{RoleARN: "arn:aws:iam::111111111111:role/a", SessionName: "a"},
+		{RoleARN: "arn:aws:iam::222222222222:role/b", SessionName: "b"},
+	})
+	if err != nil {
+		t.Fatalf("assume role chain: %v", err)
+	}
+
+	creds, err := provider.Retrieve(context.Background())
+	if err != nil {
+		t.Fatalf("retrieve credentials: %v", err)
+	}
+	if creds.AccessKeyID != "AKIDSTEP2" || !creds.CanExpire {
+		t.Fatalf("unexpected final credentials: %#v", creds)
+	}
+	if strings.Join(signedWith, ",") != "AKIDBASE,AKIDSTEP1" {
+		t.Fatalf("unexpected signing credentials per hop: %v", signedWith)
+	}
+}
+
+func TestAssumeRoleWithMFA_ValidatesTokenCode(t *testing.T) {
+	t.Parallel()
+
+	factory := &Factory{cfg: aws.Config{Region: "us-east-1"}}
+	serial := "arn:aws:iam::111111111111:mfa/admin"
+
+	for _, code := range []string{"", "12345", "1234567", "12a456"} {
+		_, err := factory.AssumeRoleWithMFA(context.Background(), "arn:aws:iam::111111111111:role/a", "a", serial, code, 0)
+		if err == nil || !strings.Contains(err.Error(), "6 digits") {
+			t.Fatalf("expected token code validation error for %q, got: %v", code, err)
+		}
+	}
+}
+
+func TestAssumeRoleWithMFA_SendsSerialAndTokenCode(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("parse form: %v", err)
+		}
+		if r.PostForm.Get("SerialNumber") != "arn:aws:iam::111111111111:mfa/admin" || r.PostForm.Get("TokenCode") != "123456" {
+			t.Fatalf("unexpected MFA parameters: %v", r.PostForm)
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AKIDMFA</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>session</SessionToken>
+      <Expiration>2030-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`)
+	}))
+	defer server.Close()
+
+	factory := &Factory{cfg: aws.Config{
+		Region:       "us-east-1",
+		BaseEndpoint: aws.String(server.URL),
+		HTTPClient:   server.Client(),
+		Credentials: staticCredentials(aws.Credentials{
+			AccessKeyID:     "AKIDBASE",
+			SecretAccessKey: "secret",
+		}),
+	}}
+
+	creds, err := factory.AssumeRoleWithMFA(
+		context.Background(),
+		"arn:aws:iam::111111111111:role/admin",
+		"admin",
+		"arn:aws:iam::111111111111:mfa/admin",
+		"123456",
+		time.Hour,
+	)
+	if err != nil {
+		t.Fatalf("assume role with MFA: %v", err)
+	}
+	if aws.ToString(creds.AccessKeyId) != "AKIDMFA" {
+		t.Fatalf("unexpected credentials: %#v", creds)
+	}
+}
@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,6 +10,9 @@ import (
 	"strings"
 )
 
+// ErrAccessAppNotFound indicates a requested Access application does not exist.
+var ErrAccessAppNotFound = errors.New("cloudflare access application not found")
+
 // AccessService provides Cloudflare Access and Zero Trust API operations.
 type AccessService struct {
 	client *Client
@@ -30,7 +34,7 @@ func (a *AccessService) Do(
 	out any,
 	reqOpts ...RequestOption,
 ) error {
-	prefix, err := scope.PathPrefix()
+	prefix, err := scopePrefix(ctx, scope)
 	if err != nil {
 		return err
 	}
@@ -91,6 +95,150 @@ func (a *AccessService) CreateApplication(
 	)
 }
 
+// GetApplication fetches an Access application at account or zone scope. A
+// missing application is reported as ErrAccessAppNotFound.
+func (a *AccessService) GetApplication(
+	ctx context.Context,
+	scope Scope,
+	appID string,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := accessAppEndpoint(appID)
+	if err != nil {
+		return err
+	}
+
+	err = a.Do(ctx, scope, http.MethodGet, endpoint, nil, nil, out, reqOpts...)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrAccessAppNotFound, strings.TrimSpace(appID))
+	}
+	return err
+}
+
+// UpdateApplication replaces an Access application at account or zone scope.
+func (a *AccessService) UpdateApplication(
+	ctx context.Context,
+	scope Scope,
+	appID string,
+	requestBody any,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := accessAppEndpoint(appID)
+	if err != nil {
+		return err
+	}
+	return a.Do(ctx, scope, http.MethodPut, endpoint, nil, requestBody, out, reqOpts...)
+}
+
+// DeleteApplication deletes an Access application at account or zone scope.
+func (a *AccessService) DeleteApplication(
+	ctx context.Context,
+	scope Scope,
+	appID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := accessAppEndpoint(appID)
+	if err != nil {
+		return err
+	}
+	return a.Do(ctx, scope, http.MethodDelete, endpoint, nil, nil, nil, reqOpts...)
+}
+
+// ListApplications lists every Access application at account or zone scope,
+// following pagination, and decodes them into out, typically a pointer to a
+// slice. No applications decode as an empty slice.
+func (a *AccessService) ListApplications(
+	ctx context.Context,
+	scope Scope,
+	params url.Values,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	prefix, err := scopePrefix(ctx, scope)
+	if err != nil {
+		return err
+	}
+
+	return a.listInto(ctx, fmt.Sprintf("/%s/access/apps", prefix), params, "access application list", out, reqOpts...)
+}
+
+// ListIdentityProviders lists every Access identity provider in an account and
+// decodes the full provider objects into out, typically a pointer to a slice.
+func (a *AccessService) ListIdentityProviders(
+	ctx context.Context,
+	accountID string,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return err
+	}
+	return a.listInto(
+		ctx,
+		fmt.Sprintf("/%s/access/identity_providers", prefix),
+		nil,
+		"access identity provider list",
+		out,
+		reqOpts...,
+	)
+}
+
+// DeleteIdentityProvider deletes an Access identity provider.
+func (a *AccessService) DeleteIdentityProvider(
+	ctx context.Context,
+	accountID string,
+	idpID string,
+	reqOpts ...RequestOption,
+) error {
+	cleanIDPID := strings.TrimSpace(idpID)
+	if cleanIDPID == "" {
+		return errors.New("identity provider ID must not be empty")
+	}
+
+	return a.Do(
+		ctx,
+		AccountScope(accountID),
+		http.MethodDelete,
+		fmt.Sprintf("/access/identity_providers/%s", url.PathEscape(cleanIDPID)),
+		nil,
+		nil,
+		nil,
+		reqOpts...,
+	)
+}
+
+// listInto collects every page of a list endpoint and decodes the items into
+// out. No items decode as an empty slice.
+func (a *AccessService) listInto(
+	ctx context.Context,
+	endpoint string,
+	params url.Values,
+	label string,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	items, err := listAllPages[json.RawMessage](ctx, a.client, endpoint, params, label, reqOpts...)
+	if err != nil {
+		return err
+	}
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+
+	raw, err := a.client.marshalJSON(items)
+	if err != nil {
+		return fmt.Errorf("encode cloudflare %s: %w", label, err)
+	}
+	if err := a.client.unmarshalJSON(raw, out); err != nil {
+		return fmt.Errorf("decode cloudflare %s: %w", label, err)
+	}
+	return nil
+}
+
 // CreateReusablePolicy creates a reusable Access policy at account scope.
 func (a *AccessService) CreateReusablePolicy(
 	ctx context.Context,
@@ -111,6 +259,51 @@ func (a *AccessService) CreateReusablePolicy(
 	)
 }
 
+// UpdateReusablePolicy replaces a reusable Access policy at account scope.
+func (a *AccessService) UpdateReusablePolicy(
+	ctx context.Context,
+	accountID string,
+	policyID string,
+	requestBody any,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := accessPolicyEndpoint(policyID)
+	if err != nil {
+		return err
+	}
+	return a.Do(ctx, AccountScope(accountID), http.MethodPut, endpoint, nil, requestBody, out, reqOpts...)
+}
+
+// DeleteReusablePolicy deletes a reusable Access policy at account scope.
+func (a *AccessService) DeleteReusablePolicy(
+	ctx context.Context,
+	accountID string,
+	policyID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := accessPolicyEndpoint(policyID)
+	if err != nil {
+		return err
+	}
+	return a.Do(ctx, AccountScope(accountID), http.MethodDelete, endpoint, nil, nil, nil, reqOpts...)
+}
+
+// ListReusablePolicies lists every reusable Access policy in an account and
+// decodes them into out, typically a pointer to a slice.
+func (a *AccessService) ListReusablePolicies(
+	ctx context.Context,
+	accountID string,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return err
+	}
+	return a.listInto(ctx, fmt.Sprintf("/%s/access/policies", prefix), nil, "access policy list", out, reqOpts...)
+}
+
 // CreateApplicationPolicy creates an application-scoped Access policy.
 func (a *AccessService) CreateApplicationPolicy(
 	ctx context.Context,
@@ -120,19 +313,35 @@ func (a *AccessService) CreateApplicationPolicy(
 	out any,
 	reqOpts ...RequestOption,
 ) error {
-	cleanAppID := strings.TrimSpace(appID)
-	if cleanAppID == "" {
-		return errors.New("app ID must not be empty")
+	endpoint, err := accessAppEndpoint(appID)
+	if err != nil {
+		return err
 	}
 
 	return a.Do(
 		ctx,
 		scope,
 		http.MethodPost,
-		fmt.Sprintf("/access/apps/%s/policies", url.PathEscape(cleanAppID)),
+		endpoint+"/policies",
 		nil,
 		requestBody,
 		out,
 		reqOpts...,
 	)
 }
+
+func accessAppEndpoint(appID string) (string, error) {
+	cleanAppID := strings.TrimSpace(appID)
+	if cleanAppID == "" {
+		return "", errors.New("app ID must not be empty")
+	}
+	return fmt.Sprintf("/access/apps/%s", url.PathEscape(cleanAppID)), nil
+}
+
+func accessPolicyEndpoint(policyID string) (string, error) {
+	cleanPolicyID := strings.TrimSpace(policyID)
+	if cleanPolicyID == "" {
+		return "", errors.New("policy ID must not be empty")
+	}
+	return fmt.Sprintf("/access/policies/%s", url.PathEscape(cleanPolicyID)), nil
+}
@@ -0,0 +1,97 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAccessBookmarks(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/access/bookmarks",
+			r.Method == http.MethodPut && r.URL.Path == "/accounts/acc-1/access/bookmarks/bm-1":
+			var payload Bookmark
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if payload.Name != "Wiki" || payload.LogoURL != "https://wiki.acme.com/logo.png" || !payload.AppLauncherVisible {
+				t.Fatalf("unexpected bookmark payload: %#v", payload)
+			}
+			payload.ID = "bm-1"
+			result = payload
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/bookmarks":
+			result = []map[string]any{{"id": "bm-1", "name": "Wiki", "domain": "wiki.acme.com"}}
+		case r.Method == http.MethodDelete && r.URL.Path == "/accounts/acc-1/access/bookmarks/bm-1":
+			result = map[string]any{"id": "bm-1"}
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	access := client.Access()
+	ctx := context.Background()
+	bookmark := Bookmark{
+		Name:               "Wiki",
+		Domain:             "wiki.acme.com",
+		LogoURL:            "https://wiki.acme.com/logo.png",
+		AppLauncherVisible: true,
+	}
+
+	var created Bookmark
+	if err := access.CreateBookmark(ctx, "acc-1", bookmark, &created); err != nil {
+		t.Fatalf("create bookmark: %v", err)
+	}
+	if created.ID != "bm-1" {
+		t.Fatalf("unexpected created bookmark: %#v", created)
+	}
+
+	bookmarks, err := access.ListBookmarks(ctx, "acc-1")
+	if err != nil {
+		t.Fatalf("list bookmarks: %v", err)
+	}
+	if len(bookmarks) != 1 || bookmarks[0].Domain != "wiki.acme.com" {
+		t.Fatalf("unexpected bookmarks: %#v", bookmarks)
+	}
+
+	if err := access.UpdateBookmark(ctx, "acc-1", "bm-1", bookmark, nil); err != nil {
+		t.Fatalf("update bookmark: %v", err)
+	}
+	if err := access.DeleteBookmark(ctx, "acc-1", "bm-1"); err != nil {
+		t.Fatalf("delete bookmark: %v", err)
+	}
+}
+
+func TestAccessBookmarkValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	access := client.Access()
+	ctx := context.Background()
+
+	if err := access.CreateBookmark(ctx, "acc-1", Bookmark{Domain: "wiki.acme.com"}, nil); err == nil {
+		t.Fatalf("expected empty name validation error")
+	}
+	if err := access.UpdateBookmark(ctx, "acc-1", "bm-1", Bookmark{Name: "Wiki"}, nil); err == nil {
+		t.Fatalf("expected empty domain validation error")
+	}
+	if err := access.DeleteBookmark(ctx, "acc-1", " "); err == nil {
+		t.Fatalf("expected empty bookmark ID validation error")
+	}
+}
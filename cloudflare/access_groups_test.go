@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAccessGroupLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/access/groups",
+			r.Method == http.MethodPut && r.URL.Path == "/accounts/acc-1/access/groups/grp-1":
+			var group AccessGroup
+			if err := json.NewDecoder(r.Body).Decode(&group); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			email, _ := group.Include[0]["email_domain"].(map[string]any)
+			if email["domain"] != "acme.com" {
+				t.Fatalf("unexpected include rules: %#v", group.Include)
+			}
+			group.ID = "grp-1"
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": group})
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/groups":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result":  []map[string]any{{"id": "grp-1", "name": "staff"}, {"id": "grp-2", "name": "ops"}},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/groups/grp-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "grp-1", "name": "staff"}})
+		case r.Method == http.MethodDelete && r.URL.EscapedPath() == "/accounts/acc-1/access/groups/grp%2F1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "grp/1"}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+	group := AccessGroup{
+		Name:    "staff",
+		Include: []map[string]any{{"email_domain": map[string]any{"domain": "acme.com"}}},
+	}
+
+	created, err := client.Access().CreateAccessGroup(ctx, "acc-1", group)
+	if err != nil {
+		t.Fatalf("create access group: %v", err)
+	}
+	if created.ID != "grp-1" {
+		t.Fatalf("unexpected created group: %#v", created)
+	}
+
+	groups, err := client.Access().ListAccessGroups(ctx, "acc-1")
+	if err != nil {
+		t.Fatalf("list access groups: %v", err)
+	}
+	if len(groups) != 2 || groups[1].Name != "ops" {
+		t.Fatalf("unexpected groups: %#v", groups)
+	}
+
+	got, err := client.Access().GetAccessGroup(ctx, "acc-1", "grp-1")
+	if err != nil {
+		t.Fatalf("get access group: %v", err)
+	}
+	if got.Name != "staff" {
+		t.Fatalf("unexpected group: %#v", got)
+	}
+
+	if _, err := client.Access().UpdateAccessGroup(ctx, "acc-1", "grp-1", group); err != nil {
+		t.Fatalf("update access group: %v", err)
+	}
+	if err := client.Access().DeleteAccessGroup(ctx, "acc-1", "grp/1"); err != nil {
+		t.Fatalf("delete access group: %v", err)
+	}
+}
+
+func TestAccessGroupValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.Access().CreateAccessGroup(ctx, "acc-1", AccessGroup{Name: "staff"}); err == nil {
+		t.Fatalf("expected missing include rule validation error")
+	}
+	if _, err := client.Access().CreateAccessGroup(ctx, "acc-1", AccessGroup{Include: []map[string]any{{"everyone": map[string]any{}}}}); err == nil {
+		t.Fatalf("expected empty name validation error")
+	}
+	if err := client.Access().DeleteAccessGroup(ctx, "acc-1", " "); err == nil {
+		t.Fatalf("expected empty group ID validation error")
+	}
+}
@@ -0,0 +1,219 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// AppSpec describes the desired state of an Access application. Applications
+// are matched to existing ones by Name.
+type AppSpec struct {
+	Name            string
+	Type            string
+	Domain          string
+	SessionDuration string
+	// Fields holds any further application attributes, keyed by their API
+	// JSON names. Typed fields above take precedence over matching keys.
+	Fields map[string]any
+}
+
+// ReconcileReport lists the application names affected by a reconcile run.
+type ReconcileReport struct {
+	Created   []string
+	Updated   []string
+	Deleted   []string
+	Unchanged []string
+}
+
+// ReconcileOption customizes ReconcileApplications.
+type ReconcileOption func(*reconcileConfig)
+
+type reconcileConfig struct {
+	pruneUnmanaged bool
+	reqOpts        []RequestOption
+}
+
+// WithPruneUnmanaged deletes existing applications whose name is not in the
+// desired set. It is off by default so applications managed elsewhere survive.
+func WithPruneUnmanaged() ReconcileOption {
+	return func(cfg *reconcileConfig) {
+		cfg.pruneUnmanaged = true
+	}
+}
+
+// WithReconcileRequestOptions applies request options to every API call made
+// during a reconcile run.
+func WithReconcileRequestOptions(reqOpts ...RequestOption) ReconcileOption {
+	return func(cfg *reconcileConfig) {
+		cfg.reqOpts = append(cfg.reqOpts, reqOpts...)
+	}
+}
+
+// readOnlyAppFields are application attributes set by the API that are left
+// out of update bodies.
+var readOnlyAppFields = []string{"id", "aud", "created_at", "updated_at"}
+
+type accessApp struct {
+	id     string
+	name   string
+	fields map[string]any
+}
+
+// ReconcileApplications brings the Access applications at scope in line with
+// desired: missing applications are created and drifted ones updated.
+// Existing applications are only compared on, and only changed in, the
+// attributes a spec sets; other attributes such as policies and allowed_idps
+// are sent back unchanged. On failure the report covers the changes applied
+// before the error.
+func (a *AccessService) ReconcileApplications(
+	ctx context.Context,
+	scope Scope,
+	desired []AppSpec,
+	opts ...ReconcileOption,
+) (ReconcileReport, error) {
+	var cfg reconcileConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	var report ReconcileReport
+	bodies := make(map[string]map[string]any, len(desired))
+	names := make([]string, 0, len(desired))
+	for _, spec := range desired {
+		body, err := spec.body(a.client)
+		if err != nil {
+			return report, err
+		}
+		name := body["name"].(string)
+		if _, ok := bodies[name]; ok {
+			return report, fmt.Errorf("duplicate desired access application %q", name)
+		}
+		bodies[name] = body
+		names = append(names, name)
+	}
+
+	var listed []map[string]any
+	if err := a.ListApplications(ctx, scope, nil, &listed, cfg.reqOpts...); err != nil {
+		return report, err
+	}
+	existing := make(map[string]accessApp, len(listed))
+	for _, fields := range listed {
+		app := accessApp{fields: fields}
+		app.id, _ = fields["id"].(string)
+		app.name, _ = fields["name"].(string)
+		if _, ok := existing[app.name]; ok {
+			if _, managed := bodies[app.name]; managed {
+				return report, fmt.Errorf("multiple access applications named %q", app.name)
+			}
+		}
+		existing[app.name] = app
+	}
+
+	for _, name := range names {
+		body := bodies[name]
+		current, ok := existing[name]
+		switch {
+		case !ok:
+			if err := a.CreateApplication(ctx, scope, body, nil, cfg.reqOpts...); err != nil {
+				return report, fmt.Errorf("create access application %q: %w", name, err)
+			}
+			report.Created = append(report.Created, name)
+		case appDrifted(current.fields, body):
+			if err := a.UpdateApplication(ctx, scope, current.id, updateBody(current.fields, body), nil, cfg.reqOpts...); err != nil {
+				return report, fmt.Errorf("update access application %q: %w", name, err)
+			}
+			report.Updated = append(report.Updated, name)
+		default:
+			report.Unchanged = append(report.Unchanged, name)
+		}
+	}
+
+	if !cfg.pruneUnmanaged {
+		return report, nil
+	}
+
+	unmanaged := make([]accessApp, 0)
+	for _, app := range listed {
+		name, _ := app["name"].(string)
+		if _, ok := bodies[name]; ok {
+			continue
+		}
+		id, _ := app["id"].(string)
+		unmanaged = append(unmanaged, accessApp{id: id, name: name})
+	}
+	sort.Slice(unmanaged, func(i, j int) bool { return unmanaged[i].name < unmanaged[j].name })
+	for _, app := range unmanaged {
+		err := a.DeleteApplication(ctx, scope, app.id, cfg.reqOpts...)
+		var statusErr *HTTPStatusError
+		if err != nil && !(errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound) {
+			return report, fmt.Errorf("delete access application %q: %w", app.name, err)
+		}
+		report.Deleted = append(report.Deleted, app.name)
+	}
+	return report, nil
+}
+
+// body renders the spec as an application request body, using c's JSON codec.
+func (s AppSpec) body(c *Client) (map[string]any, error) {
+	name := strings.TrimSpace(s.Name)
+	if name == "" {
+		return nil, errors.New("access application spec name must not be empty")
+	}
+
+	body := make(map[string]any, len(s.Fields)+4)
+	for key, value := range s.Fields {
+		body[key] = value
+	}
+	body["name"] = name
+	for key, value := range map[string]string{
+		"type":             s.Type,
+		"domain":           s.Domain,
+		"session_duration": s.SessionDuration,
+	} {
+		if value != "" {
+			body[key] = value
+		}
+	}
+
+	// Round-trip through JSON so comparisons see the same types as a decoded
+	// API response.
+	raw, err := c.marshalJSON(body)
+	if err != nil {
+		return nil, fmt.Errorf("encode access application spec %q: %w", name, err)
+	}
+	normalized := map[string]any{}
+	if err := c.unmarshalJSON(raw, &normalized); err != nil {
+		return nil, fmt.Errorf("decode access application spec %q: %w", name, err)
+	}
+	return normalized, nil
+}
+
+// appDrifted reports whether any attribute set in desired differs from current.
+func appDrifted(current map[string]any, desired map[string]any) bool {
+	for key, want := range desired {
+		if !reflect.DeepEqual(current[key], want) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateBody builds a full PUT body from the current application with the
+// spec's attributes overlaid, so attributes the spec does not manage survive
+// the update.
+func updateBody(current map[string]any, desired map[string]any) map[string]any {
+	body := maps.Clone(current)
+	for _, key := range readOnlyAppFields {
+		delete(body, key)
+	}
+	maps.Copy(body, desired)
+	return body
+}
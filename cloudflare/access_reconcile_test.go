@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccessReconcileApplications(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		opts        []ReconcileOption
+		wantReport  ReconcileReport
+		wantDeletes []string
+	}{
+		{
+			name: "keeps unmanaged applications by default",
+			wantReport: ReconcileReport{
+				Created:   []string{"new"},
+				Updated:   []string{"drifted"},
+				Unchanged: []string{"same"},
+			},
+		},
+		{
+			name: "prunes unmanaged applications when asked",
+			opts: []ReconcileOption{WithPruneUnmanaged()},
+			wantReport: ReconcileReport{
+				Created:   []string{"new"},
+				Updated:   []string{"drifted"},
+				Deleted:   []string{"legacy"},
+				Unchanged: []string{"same"},
+			},
+			wantDeletes: []string{"/accounts/acc-1/access/apps/app-legacy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu      sync.Mutex
+				deletes []string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/apps":
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"success": true,
+						"result": []map[string]any{
+							{"id": "app-same", "name": "same", "domain": "same.acme.com", "type": "self_hosted", "aud": "x"},
+							{
+								"id": "app-drifted", "name": "drifted", "domain": "old.acme.com", "type": "self_hosted",
+								"aud": "aud-1", "created_at": "2024-01-01T00:00:00Z", "allowed_idps": []string{"idp-1"},
+							},
+							{"id": "app-legacy", "name": "legacy", "domain": "legacy.acme.com", "type": "self_hosted"},
+						},
+					})
+				case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/access/apps":
+					var body map[string]any
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatalf("decode create body: %v", err)
+					}
+					if body["name"] != "new" || body["session_duration"] != "1h" {
+						t.Fatalf("unexpected create body: %#v", body)
+					}
+					_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": body})
+				case r.Method == http.MethodPut && r.URL.Path == "/accounts/acc-1/access/apps/app-drifted":
+					var body map[string]any
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Fatalf("decode update body: %v", err)
+					}
+					if body["domain"] != "drifted.acme.com" {
+						t.Fatalf("unexpected update body: %#v", body)
+					}
+					// Attributes the spec does not manage survive; read-only ones are dropped.
+					if !reflect.DeepEqual(body["allowed_idps"], []any{"idp-1"}) {
+						t.Fatalf("update body dropped unmanaged allowed_idps: %#v", body)
+					}
+					for _, key := range []string{"id", "aud", "created_at"} {
+						if _, ok := body[key]; ok {
+							t.Fatalf("update body carries read-only %q: %#v", key, body)
+						}
+					}
+					_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": body})
+				case r.Method == http.MethodDelete:
+					mu.Lock()
+					deletes = append(deletes, r.URL.Path)
+					mu.Unlock()
+					_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{}})
+				default:
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			report, err := client.Access().ReconcileApplications(context.Background(), AccountScope("acc-1"), []AppSpec{
+				{Name: "same", Type: "self_hosted", Domain: "same.acme.com"},
+				{Name: "drifted", Type: "self_hosted", Domain: "drifted.acme.com"},
+				{Name: "new", Type: "self_hosted", Domain: "new.acme.com", SessionDuration: "1h"},
+			}, tt.opts...)
+			if err != nil {
+				t.Fatalf("reconcile applications: %v", err)
+			}
+			if !reflect.DeepEqual(report, tt.wantReport) {
+				t.Fatalf("unexpected report: %#v", report)
+			}
+			if !reflect.DeepEqual(deletes, tt.wantDeletes) {
+				t.Fatalf("unexpected deletes: %#v", deletes)
+			}
+		})
+	}
+}
+
+func TestAccessReconcileApplicationsValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+	if _, err := client.Access().ReconcileApplications(ctx, AccountScope("acc-1"), []AppSpec{{Name: " "}}); err == nil {
+		t.Fatalf("expected empty spec name validation error")
+	}
+	_, err = client.Access().ReconcileApplications(ctx, AccountScope("acc-1"), []AppSpec{{Name: "a"}, {Name: "a"}})
+	if err == nil {
+		t.Fatalf("expected duplicate spec name validation error")
+	}
+}
@@ -0,0 +1,70 @@
+package cloudflare
+
+import "strings"
+
+// AccessRules builds Access policy rule arrays for include, exclude, and require.
+type AccessRules struct {
+	rules []map[string]any
+}
+
+// NewAccessRules returns an empty Access policy rule builder.
+func NewAccessRules() *AccessRules {
+	return &AccessRules{}
+}
+
+// Email matches a single email address.
+func (r *AccessRules) Email(email string) *AccessRules {
+	return r.add("email", map[string]any{"email": strings.TrimSpace(email)})
+}
+
+// EmailDomain matches every email address in a domain.
+func (r *AccessRules) EmailDomain(domain string) *AccessRules {
+	return r.add("email_domain", map[string]any{"domain": strings.TrimSpace(domain)})
+}
+
+// Group matches members of an Access group.
+func (r *AccessRules) Group(groupID string) *AccessRules {
+	return r.add("group", map[string]any{"id": strings.TrimSpace(groupID)})
+}
+
+// IdentityProvider matches users who authenticated with a login method.
+func (r *AccessRules) IdentityProvider(identityProviderID string) *AccessRules {
+	return r.add("login_method", map[string]any{"id": strings.TrimSpace(identityProviderID)})
+}
+
+// ServiceToken matches a single Access service token.
+func (r *AccessRules) ServiceToken(tokenID string) *AccessRules {
+	return r.add("service_token", map[string]any{"token_id": strings.TrimSpace(tokenID)})
+}
+
+// AnyValidServiceToken matches any valid Access service token.
+func (r *AccessRules) AnyValidServiceToken() *AccessRules {
+	return r.add("any_valid_service_token", map[string]any{})
+}
+
+// IPRange matches requests from an IP address or CIDR range.
+func (r *AccessRules) IPRange(cidr string) *AccessRules {
+	return r.add("ip", map[string]any{"ip": strings.TrimSpace(cidr)})
+}
+
+// Country matches requests from an ISO 3166-1 alpha-2 country code.
+func (r *AccessRules) Country(countryCode string) *AccessRules {
+	return r.add("geo", map[string]any{"country_code": strings.ToUpper(strings.TrimSpace(countryCode))})
+}
+
+// Everyone matches all users.
+func (r *AccessRules) Everyone() *AccessRules {
+	return r.add("everyone", map[string]any{})
+}
+
+// Build returns the rule array in Cloudflare policy JSON shape.
+func (r *AccessRules) Build() []map[string]any {
+	out := make([]map[string]any, len(r.rules))
+	copy(out, r.rules)
+	return out
+}
+
+func (r *AccessRules) add(kind string, value map[string]any) *AccessRules {
+	r.rules = append(r.rules, map[string]any{kind: value})
+	return r
+}
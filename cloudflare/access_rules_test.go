@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAccessRulesBuild(t *testing.T) {
+	t.Parallel()
+
+	rules := NewAccessRules().
+		Email("ops@acme.com").
+		EmailDomain("acme.com").
+		Group("grp-1").
+		IdentityProvider("idp-1").
+		ServiceToken("tok-1").
+		AnyValidServiceToken().
+		IPRange("10.0.0.0/8").
+		Country("us").
+		Everyone().
+		Build()
+
+	got, err := json.Marshal(rules)
+	if err != nil {
+		t.Fatalf("marshal rules: %v", err)
+	}
+
+	want := `[{"email":{"email":"ops@acme.com"}},` +
+		`{"email_domain":{"domain":"acme.com"}},` +
+		`{"group":{"id":"grp-1"}},` +
+		`{"login_method":{"id":"idp-1"}},` +
+		`{"service_token":{"token_id":"tok-1"}},` +
+		`{"any_valid_service_token":{}},` +
+		`{"ip":{"ip":"10.0.0.0/8"}},` +
+		`{"geo":{"country_code":"US"}},` +
+		`{"everyone":{}}]`
+	if string(got) != want {
+		t.Fatalf("unexpected rules JSON:\ngot=%s\nwant=%s", got, want)
+	}
+}
+
+func TestAccessRulesBuildEmpty(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(NewAccessRules().Build())
+	if err != nil {
+		t.Fatalf("marshal rules: %v", err)
+	}
+	if string(got) != "[]" {
+		t.Fatalf("expected empty JSON array, got: %s", got)
+	}
+}
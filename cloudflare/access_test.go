@@ -3,8 +3,12 @@ package cloudflare
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 )
@@ -203,3 +207,222 @@ func TestAccessDoRejectsInvalidScope(t *testing.T) {
 		t.Fatalf("expected invalid scope error")
 	}
 }
+
+func TestAccessListApplicationsPaginates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/zones/zone-1/access/apps" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("name") != "internal" {
+			t.Fatalf("expected caller params to be forwarded, got %s", r.URL.RawQuery)
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": "app-" + strconv.Itoa(page), "name": "internal"}},
+			"result_info": map[string]any{"page": page, "total_pages": 2},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var apps []struct {
+		ID string `json:"id"`
+	}
+	err = client.Access().ListApplications(context.Background(), ZoneScope("zone-1"), url.Values{"name": {"internal"}}, &apps)
+	if err != nil {
+		t.Fatalf("list applications: %v", err)
+	}
+	if len(apps) != 2 || apps[0].ID != "app-1" || apps[1].ID != "app-2" {
+		t.Fatalf("unexpected applications: %#v", apps)
+	}
+}
+
+func TestAccessListApplicationsEmpty(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var apps []map[string]any
+	if err := client.Access().ListApplications(context.Background(), AccountScope("acc-1"), nil, &apps); err != nil {
+		t.Fatalf("list applications: %v", err)
+	}
+	if apps == nil || len(apps) != 0 {
+		t.Fatalf("expected an empty non-nil slice, got %#v", apps)
+	}
+}
+
+func TestAccessApplicationGetUpdateDelete(t *testing.T) {
+	t.Parallel()
+
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/accounts/acc-1/access/apps/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":12130,"message":"access.api.error.not_found"}]}`))
+			return
+		}
+		if r.URL.EscapedPath() != "/accounts/acc-1/access/apps/app%2F1" {
+			t.Fatalf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		methods = append(methods, r.Method)
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "app/1", "name": "internal"}})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+	scope := AccountScope("acc-1")
+
+	var app map[string]any
+	if err := client.Access().GetApplication(ctx, scope, "app/1", &app); err != nil {
+		t.Fatalf("get application: %v", err)
+	}
+	if app["name"] != "internal" {
+		t.Fatalf("unexpected application: %#v", app)
+	}
+	if err := client.Access().UpdateApplication(ctx, scope, "app/1", map[string]any{"name": "internal"}, nil); err != nil {
+		t.Fatalf("update application: %v", err)
+	}
+	if err := client.Access().DeleteApplication(ctx, scope, "app/1"); err != nil {
+		t.Fatalf("delete application: %v", err)
+	}
+	if strings.Join(methods, ",") != "GET,PUT,DELETE" {
+		t.Fatalf("unexpected methods: %v", methods)
+	}
+
+	err = client.Access().GetApplication(ctx, scope, "missing", &app)
+	if !errors.Is(err, ErrAccessAppNotFound) {
+		t.Fatalf("expected ErrAccessAppNotFound, got %v", err)
+	}
+	if err := client.Access().DeleteApplication(ctx, scope, " "); err == nil {
+		t.Fatalf("expected empty app ID validation error")
+	}
+}
+
+func TestAccessListAndDeleteIdentityProviders(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/identity_providers":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result": []map[string]any{
+					{"id": "idp-1", "name": "okta", "type": "okta", "config": map[string]any{"okta_account": "https://acme.okta.com"}},
+					{"id": "idp-2", "name": "github", "type": "github"},
+				},
+			})
+		case r.Method == http.MethodDelete && r.URL.EscapedPath() == "/accounts/acc-1/access/identity_providers/idp%201":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "idp 1"}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	var providers []struct {
+		ID     string         `json:"id"`
+		Name   string         `json:"name"`
+		Type   string         `json:"type"`
+		Config map[string]any `json:"config"`
+	}
+	if err := client.Access().ListIdentityProviders(ctx, "acc-1", &providers); err != nil {
+		t.Fatalf("list identity providers: %v", err)
+	}
+	if len(providers) != 2 || providers[0].Type != "okta" || providers[0].Config["okta_account"] == nil {
+		t.Fatalf("unexpected providers: %#v", providers)
+	}
+
+	if err := client.Access().DeleteIdentityProvider(ctx, "acc-1", "idp 1"); err != nil {
+		t.Fatalf("delete identity provider: %v", err)
+	}
+	if err := client.Access().DeleteIdentityProvider(ctx, "acc-1", " "); err == nil {
+		t.Fatalf("expected empty identity provider ID validation error")
+	}
+}
+
+func TestAccessReusablePolicyLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/access/policies":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result":  []map[string]any{{"id": "pol-1", "name": "admins", "decision": "allow"}},
+			})
+		case r.Method == http.MethodPut && r.URL.EscapedPath() == "/accounts/acc-1/access/policies/pol%2F1":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			body["id"] = "pol/1"
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": body})
+		case r.Method == http.MethodDelete && r.URL.EscapedPath() == "/accounts/acc-1/access/policies/pol%2F1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "pol/1"}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.EscapedPath())
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	var policies []map[string]any
+	if err := client.Access().ListReusablePolicies(ctx, "acc-1", &policies); err != nil {
+		t.Fatalf("list reusable policies: %v", err)
+	}
+	if len(policies) != 1 || policies[0]["name"] != "admins" {
+		t.Fatalf("unexpected policies: %#v", policies)
+	}
+
+	var updated map[string]any
+	err = client.Access().UpdateReusablePolicy(ctx, "acc-1", "pol/1", map[string]any{"name": "admins", "decision": "deny"}, &updated)
+	if err != nil {
+		t.Fatalf("update reusable policy: %v", err)
+	}
+	if updated["decision"] != "deny" {
+		t.Fatalf("unexpected update response: %#v", updated)
+	}
+
+	if err := client.Access().DeleteReusablePolicy(ctx, "acc-1", "pol/1"); err != nil {
+		t.Fatalf("delete reusable policy: %v", err)
+	}
+	if err := client.Access().DeleteReusablePolicy(ctx, "acc-1", ""); err == nil {
+		t.Fatalf("expected empty policy ID validation error")
+	}
+}
@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// maxPooledBodyBytes keeps unusually large buffers out of the pool so one big
+// request does not pin that memory for the life of the process.
+const maxPooledBodyBytes = 64 << 10
+
+var bodyPool = sync.Pool{
+	New: func() any {
+		body := &pooledBody{}
+		body.enc = json.NewEncoder(&body.buf)
+		return body
+	},
+}
+
+// pooledBody is a JSON request body encoded into a pooled buffer. It is
+// reference counted because the transport may close a request body after
+// RoundTrip returns; it goes back to the pool once the caller and every
+// request reader have released it.
+type pooledBody struct {
+	buf  bytes.Buffer
+	enc  *json.Encoder
+	refs atomic.Int32
+}
+
+func encodePooledBody(v any) (*pooledBody, error) {
+	body := bodyPool.Get().(*pooledBody)
+	body.buf.Reset()
+
+	if err := body.enc.Encode(v); err != nil {
+		putPooledBody(body)
+		return nil, err
+	}
+	// Encode terminates the value with a newline that json.Marshal omits.
+	body.buf.Truncate(body.buf.Len() - 1)
+
+	body.refs.Store(1)
+	return body, nil
+}
+
+// Bytes returns the encoded body. It is valid until the final release.
+func (b *pooledBody) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// newReader returns a request body reader that holds a reference until closed.
+func (b *pooledBody) newReader() io.ReadCloser {
+	b.refs.Add(1)
+	return &pooledBodyReader{Reader: bytes.NewReader(b.buf.Bytes()), owner: b}
+}
+
+func (b *pooledBody) release() {
+	if b.refs.Add(-1) == 0 {
+		putPooledBody(b)
+	}
+}
+
+func putPooledBody(body *pooledBody) {
+	if body.buf.Cap() > maxPooledBodyBytes {
+		return
+	}
+	bodyPool.Put(body)
+}
+
+type pooledBodyReader struct {
+	*bytes.Reader
+	owner  *pooledBody
+	closed atomic.Bool
+}
+
+func (r *pooledBodyReader) Close() error {
+	if r.closed.CompareAndSwap(false, true) {
+		r.owner.release()
+	}
+	return nil
+}
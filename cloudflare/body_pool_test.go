@@ -0,0 +1,143 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEncodePooledBodyMatchesJSONMarshal(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]any{"name": "<www>", "ttl": 1, "proxied": true}
+	body, err := encodePooledBody(value)
+	if err != nil {
+		t.Fatalf("encode pooled body: %v", err)
+	}
+	defer body.release()
+
+	want, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(body.Bytes()) != string(want) {
+		t.Fatalf("expected %s, got %s", want, body.Bytes())
+	}
+}
+
+func TestEncodePooledBodyError(t *testing.T) {
+	t.Parallel()
+
+	if _, err := encodePooledBody(map[string]any{"bad": make(chan int)}); err == nil {
+		t.Fatalf("expected encode error")
+	}
+}
+
+func TestPooledBodyReleasedAfterReadersClose(t *testing.T) {
+	t.Parallel()
+
+	body, err := encodePooledBody(map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatalf("encode pooled body: %v", err)
+	}
+
+	first := body.newReader()
+	second := body.newReader()
+	body.release()
+
+	if _, err := io.ReadAll(first); err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	_ = first.Close()
+	_ = first.Close()
+	if body.refs.Load() != 1 {
+		t.Fatalf("expected a repeated close to release once, got %d references", body.refs.Load())
+	}
+
+	data, err := io.ReadAll(second)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	if string(data) != `{"a":"b"}` {
+		t.Fatalf("unexpected body while a reader is open: %s", data)
+	}
+	_ = second.Close()
+}
+
+func TestDo_PooledBodiesConcurrent(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			ID string `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": payload})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for i := range 50 {
+		wg.Go(func() {
+			id := fmt.Sprintf("item-%d", i)
+			var out struct {
+				ID string `json:"id"`
+			}
+			err := client.Do(context.Background(), http.MethodPost, "/echo", nil, map[string]string{"id": id}, &out)
+			if err == nil && out.ID != id {
+				err = fmt.Errorf("expected %s, got %s", id, out.ID)
+			}
+			errs <- err
+		})
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent request: %v", err)
+		}
+	}
+}
+
+func BenchmarkEncodeRequestBody(b *testing.B) {
+	record := DNSRecord{Type: "A", Name: "www.example.com", Content: "192.0.2.1", TTL: 300}
+
+	b.Run("json.Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			payload, err := json.Marshal(record)
+			if err != nil {
+				b.Fatal(err)
+			}
+			_ = payload
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			body, err := encodePooledBody(record)
+			if err != nil {
+				b.Fatal(err)
+			}
+			body.release()
+		}
+	})
+}
@@ -0,0 +1,184 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redirectItemBatchSize is the largest number of items sent in one request.
+const redirectItemBatchSize = 1000
+
+// RedirectItem is a single bulk redirect from SourceURL to TargetURL.
+type RedirectItem struct {
+	SourceURL          string `json:"source_url"`
+	TargetURL          string `json:"target_url"`
+	StatusCode         int    `json:"status_code,omitempty"`
+	PreservePathSuffix bool   `json:"preserve_path_suffix,omitempty"`
+}
+
+type redirectListItem struct {
+	ID       string       `json:"id,omitempty"`
+	Redirect RedirectItem `json:"redirect"`
+}
+
+// BulkRedirectsService provides Cloudflare bulk redirect list operations.
+type BulkRedirectsService struct {
+	client *Client
+}
+
+// BulkRedirects returns the bulk redirects service API.
+func (c *Client) BulkRedirects() *BulkRedirectsService {
+	return &BulkRedirectsService{client: c}
+}
+
+// CreateList creates an empty redirect list.
+func (b *BulkRedirectsService) CreateList(
+	ctx context.Context,
+	accountID string,
+	name string,
+	reqOpts ...RequestOption,
+) (*RuleList, error) {
+	endpoint, err := listsPath(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	cleanName := strings.TrimSpace(name)
+	if cleanName == "" {
+		return nil, errors.New("redirect list name must not be empty")
+	}
+
+	var list RuleList
+	body := map[string]string{"name": cleanName, "kind": "redirect"}
+	if err := b.client.DoWithOptions(ctx, http.MethodPost, endpoint, nil, body, &list, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AppendItems adds items to a redirect list, splitting them into batches of
+// at most 1000. Cloudflare applies each batch asynchronously, so AppendItems
+// waits for each batch's bulk operation to complete before sending the next
+// and returns once the last one has been applied.
+func (b *BulkRedirectsService) AppendItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	items []RedirectItem,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(items); start += redirectItemBatchSize {
+		batch := wrapRedirectItems(items[start:min(start+redirectItemBatchSize, len(items))])
+		if err := b.sendBatch(ctx, accountID, http.MethodPost, endpoint, batch, reqOpts...); err != nil {
+			return fmt.Errorf("append redirect items %d-%d: %w", start, start+len(batch)-1, err)
+		}
+	}
+	return nil
+}
+
+// ReplaceItems replaces every item in a redirect list. The first batch
+// replaces the list contents and any remaining batches are appended, each
+// only after the previous bulk operation has completed.
+func (b *BulkRedirectsService) ReplaceItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	items []RedirectItem,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return err
+	}
+
+	first := wrapRedirectItems(items[:min(redirectItemBatchSize, len(items))])
+	if err := b.sendBatch(ctx, accountID, http.MethodPut, endpoint, first, reqOpts...); err != nil {
+		return fmt.Errorf("replace redirect items: %w", err)
+	}
+	if len(items) <= redirectItemBatchSize {
+		return nil
+	}
+	return b.AppendItems(ctx, accountID, listID, items[redirectItemBatchSize:], reqOpts...)
+}
+
+// ListItems returns every item in a redirect list, following result cursors.
+func (b *BulkRedirectsService) ListItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	reqOpts ...RequestOption,
+) ([]RedirectItem, error) {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := listAllPages[redirectListItem](ctx, b.client, endpoint, nil, "redirect list items", reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []RedirectItem
+	for _, item := range page {
+		all = append(all, item.Redirect)
+	}
+	return all, nil
+}
+
+// sendBatch sends one batch of items and waits for the bulk operation it
+// starts, so batches are applied in order.
+func (b *BulkRedirectsService) sendBatch(
+	ctx context.Context,
+	accountID string,
+	method string,
+	endpoint string,
+	batch []redirectListItem,
+	reqOpts ...RequestOption,
+) error {
+	var ref bulkOperationRef
+	if err := b.client.DoWithOptions(ctx, method, endpoint, nil, batch, &ref, reqOpts...); err != nil {
+		return err
+	}
+	if ref.OperationID == "" {
+		return nil
+	}
+	_, err := b.client.Lists().WaitForBulkOperation(ctx, accountID, ref.OperationID, defaultBulkOperationPollInterval)
+	return err
+}
+
+func wrapRedirectItems(items []RedirectItem) []redirectListItem {
+	wrapped := make([]redirectListItem, len(items))
+	for i, item := range items {
+		wrapped[i] = redirectListItem{Redirect: item}
+	}
+	return wrapped
+}
+
+func listsPath(ctx context.Context, accountID string) (string, error) {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/%s/rules/lists", prefix), nil
+}
+
+func listItemsPath(ctx context.Context, accountID string, listID string) (string, error) {
+	collection, err := listsPath(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+
+	cleanListID := strings.TrimSpace(listID)
+	if cleanListID == "" {
+		return "", errors.New("list ID must not be empty")
+	}
+	return fmt.Sprintf("%s/%s/items", collection, url.PathEscape(cleanListID)), nil
+}
@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBulkRedirectsCreateList(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/accounts/acc-1/rules/lists" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if payload["name"] != "marketing" || payload["kind"] != "redirect" {
+			t.Fatalf("unexpected payload: %#v", payload)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  map[string]any{"id": "list-1", "name": "marketing", "kind": "redirect"},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	list, err := client.BulkRedirects().CreateList(context.Background(), "acc-1", "marketing")
+	if err != nil {
+		t.Fatalf("create list: %v", err)
+	}
+	if list.ID != "list-1" || list.Kind != "redirect" {
+		t.Fatalf("unexpected list: %#v", list)
+	}
+}
+
+func TestBulkRedirectsReplaceItemsBatches(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	var sizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			if r.URL.Path != fmt.Sprintf("/accounts/acc-1/rules/lists/bulk_operations/op-%d", len(sizes)) {
+				t.Fatalf("unexpected status path: %s", r.URL.Path)
+			}
+			requests = append(requests, "WAIT")
+			_, _ = w.Write([]byte(`{"success":true,"result":{"id":"op","status":"completed"}}`))
+			return
+		}
+		if r.URL.Path != "/accounts/acc-1/rules/lists/list-1/items" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var payload []redirectListItem
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		requests = append(requests, r.Method)
+		sizes = append(sizes, len(payload))
+		_, _ = fmt.Fprintf(w, `{"success":true,"result":{"operation_id":"op-%d"}}`, len(sizes))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	items := make([]RedirectItem, 2500)
+	for i := range items {
+		items[i] = RedirectItem{SourceURL: fmt.Sprintf("example.com/%d", i), TargetURL: "https://example.com/", StatusCode: 301}
+	}
+
+	if err := client.BulkRedirects().ReplaceItems(context.Background(), "acc-1", "list-1", items); err != nil {
+		t.Fatalf("replace items: %v", err)
+	}
+	if fmt.Sprint(requests) != "[PUT WAIT POST WAIT POST WAIT]" || fmt.Sprint(sizes) != "[1000 1000 500]" {
+		t.Fatalf("unexpected batches: %v %v", requests, sizes)
+	}
+}
+
+func TestBulkRedirectsReplaceItemsStopsOnFailedOperation(t *testing.T) {
+	t.Parallel()
+
+	var writes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"success":true,"result":{"id":"op-1","status":"failed","error":"list is locked"}}`))
+			return
+		}
+		writes++
+		_, _ = w.Write([]byte(`{"success":true,"result":{"operation_id":"op-1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	items := make([]RedirectItem, 1500)
+	for i := range items {
+		items[i] = RedirectItem{SourceURL: fmt.Sprintf("example.com/%d", i), TargetURL: "https://example.com/"}
+	}
+
+	err = client.BulkRedirects().ReplaceItems(context.Background(), "acc-1", "list-1", items)
+	if !errors.Is(err, ErrBulkOperationFailed) {
+		t.Fatalf("expected bulk operation failure, got %v", err)
+	}
+	if writes != 1 {
+		t.Fatalf("expected no batch after the failed operation, got %d writes", writes)
+	}
+}
+
+func TestBulkRedirectsListItemsFollowsCursors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success":     true,
+				"result":      []map[string]any{{"id": "i1", "redirect": map[string]any{"source_url": "a.com/", "target_url": "https://b.com/"}}},
+				"result_info": map[string]any{"cursors": map[string]any{"after": "next"}},
+			})
+		case "next":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success":     true,
+				"result":      []map[string]any{{"id": "i2", "redirect": map[string]any{"source_url": "c.com/", "target_url": "https://d.com/", "preserve_path_suffix": true}}},
+				"result_info": map[string]any{"cursors": map[string]any{}},
+			})
+		default:
+			t.Fatalf("unexpected cursor: %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	items, err := client.BulkRedirects().ListItems(context.Background(), "acc-1", "list-1")
+	if err != nil {
+		t.Fatalf("list items: %v", err)
+	}
+	if len(items) != 2 || items[1].SourceURL != "c.com/" || !items[1].PreservePathSuffix {
+		t.Fatalf("unexpected items: %#v", items)
+	}
+}
@@ -0,0 +1,44 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// PurgeRequest selects what PurgeCache removes. Exactly one mode must be set.
+type PurgeRequest struct {
+	PurgeEverything bool     `json:"purge_everything,omitempty"`
+	Files           []string `json:"files,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+	Hosts           []string `json:"hosts,omitempty"`
+}
+
+// PurgeCache removes cached content for a zone, either everything or the
+// given files, cache tags, or hosts.
+func (c *Client) PurgeCache(ctx context.Context, zoneID string, req PurgeRequest, reqOpts ...RequestOption) error {
+	prefix, err := scopePrefix(ctx, ZoneScope(zoneID))
+	if err != nil {
+		return err
+	}
+	if err := req.validate(); err != nil {
+		return err
+	}
+
+	endpoint := fmt.Sprintf("/%s/purge_cache", prefix)
+	return c.DoWithOptions(ctx, http.MethodPost, endpoint, nil, req, nil, reqOpts...)
+}
+
+func (r PurgeRequest) validate() error {
+	modes := 0
+	for _, set := range []bool{r.PurgeEverything, len(r.Files) > 0, len(r.Tags) > 0, len(r.Hosts) > 0} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return errors.New("purge request must set exactly one of PurgeEverything, Files, Tags, or Hosts")
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPurgeCache(t *testing.T) {
+	t.Parallel()
+
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/zones/zone-1/purge_cache" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{"id":"zone-1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.PurgeCache(ctx, "zone-1", PurgeRequest{PurgeEverything: true}); err != nil {
+		t.Fatalf("purge everything: %v", err)
+	}
+	if err := client.PurgeCache(ctx, "zone-1", PurgeRequest{Tags: []string{"release-42"}}); err != nil {
+		t.Fatalf("purge tags: %v", err)
+	}
+	want := []map[string]any{
+		{"purge_everything": true},
+		{"tags": []any{"release-42"}},
+	}
+	if !reflect.DeepEqual(bodies, want) {
+		t.Fatalf("unexpected purge bodies: %#v", bodies)
+	}
+}
+
+func TestPurgeCacheValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	for _, req := range []PurgeRequest{
+		{},
+		{PurgeEverything: true, Files: []string{"https://acme.com/app.js"}},
+		{Tags: []string{"a"}, Hosts: []string{"acme.com"}},
+	} {
+		if err := client.PurgeCache(context.Background(), "zone-1", req); err == nil {
+			t.Fatalf("expected validation error for %#v", req)
+		}
+	}
+}
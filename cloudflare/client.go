@@ -9,39 +9,100 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
+	"golang.org/x/sync/singleflight"
+
+	"github.com/d-padmanabhan/platform-core-go/autherr"
 	"github.com/d-padmanabhan/platform-core-go/internal/httpx"
 )
 
 const (
 	defaultBaseURL = "https://api.cloudflare.com/client/v4"
 	// #nosec G101 -- environment variable key, not a credential value.
-	defaultTokenEnv          = "CLOUDFLARE_API_TOKEN"
+	defaultTokenEnv = "CLOUDFLARE_API_TOKEN"
+	defaultEmailEnv = "CLOUDFLARE_EMAIL"
+	// #nosec G101 -- environment variable key, not a credential value.
+	defaultAPIKeyEnv         = "CLOUDFLARE_API_KEY"
 	defaultMaxRetriesEnv     = "CLOUDFLARE_HTTP_MAX_RETRIES"
 	defaultRetryBaseDelayEnv = "CLOUDFLARE_HTTP_RETRY_BASE_DELAY_SECONDS"
 	defaultRetryMaxDelayEnv  = "CLOUDFLARE_HTTP_RETRY_MAX_DELAY_SECONDS"
 	defaultMaxRetries        = 3
 	defaultRetryBaseDelay    = 1 * time.Second
 	defaultRetryMaxDelay     = 30 * time.Second
+	defaultMaxRequestBytes   = 25 << 20
+	// headerAPIVersion carries the dated API version pinned by WithAPIVersion.
+	headerAPIVersion = "Cf-Api-Version"
+	apiVersionLayout = "2006-01-02"
 )
 
 // ErrZoneNotFound indicates no matching zone was returned by Cloudflare.
 var ErrZoneNotFound = errors.New("cloudflare zone not found")
 
+// ErrRequestTooLarge indicates a marshaled request body exceeds the configured limit.
+var ErrRequestTooLarge = errors.New("cloudflare request body too large")
+
+// ErrOperationTimeout indicates a request, including all of its retries,
+// exceeded the budget set with WithOperationBudget.
+var ErrOperationTimeout = errors.New("cloudflare operation budget exceeded")
+
 // Config controls Cloudflare client behavior.
 type Config struct {
-	BaseURL        string
-	Timeout        time.Duration
-	MaxRetries     int
-	RetryBaseDelay time.Duration
-	RetryMaxDelay  time.Duration
-	HTTPClient     *http.Client
+	BaseURL         string
+	Timeout         time.Duration
+	OperationBudget time.Duration
+	MaxRetries      int
+	RetryBaseDelay  time.Duration
+	RetryMaxDelay   time.Duration
+	MaxRequestBytes int64
+	HTTPClient      *http.Client
+	RedirectPolicy  func(req *http.Request, via []*http.Request) error
+	HTTPTrace       func(ctx context.Context) *httptrace.ClientTrace
+	CurlLog         io.Writer
+	// UserAgent is sent on every request when non-empty. It defaults to
+	// "platform-core-go/<version>".
+	UserAgent string
+	// APIVersion is a YYYY-MM-DD API version date sent on every request when
+	// non-empty.
+	APIVersion string
+
+	ResponseHeaderTimeout   time.Duration
+	MaxResponseHeaderBytes  int64
+	TraceContextPropagation bool
+	RequestCoalescing       bool
+	RateLimitAwareness      bool
+	MaxConcurrentRetries    int
+	// ParallelPagination is the number of workers fetching the remaining pages
+	// of page-numbered list endpoints once the first page reports total_pages.
+	// Values below 2 fetch pages sequentially.
+	ParallelPagination int
+
+	// ResponseInspector, when set, receives every response and its body.
+	ResponseInspector func(resp *http.Response, body []byte)
+	// Logger, when set, receives a debug record per attempt and scheduled
+	// retry. Only the method, URL path, status, and timings are logged.
+	Logger *slog.Logger
+
+	// JSONMarshal and JSONUnmarshal replace encoding/json for request and
+	// response bodies. Nil uses the standard library.
+	JSONMarshal   func(v any) ([]byte, error)
+	JSONUnmarshal func(data []byte, v any) error
+
+	// globalAPIEmail and globalAPIKey are moved onto the Client by New so the
+	// key never appears in Config.
+	globalAPIEmail string
+	globalAPIKey   string
 }
 
 // Option configures Client construction behavior.
@@ -52,6 +113,13 @@ type RequestOption func(*requestConfig)
 
 type requestConfig struct {
 	retryUnsafeMethods bool
+	idempotencyKey     string
+	requestID          string
+	headers            http.Header
+	maxPages           int
+	listOptions        *ListOptions
+	perPage            int
+	sequentialPages    bool
 }
 
 // WithBaseURL overrides the default Cloudflare API base URL.
@@ -61,7 +129,8 @@ func WithBaseURL(baseURL string) Option {
 	}
 }
 
-// WithHTTPClient injects a custom HTTP client.
+// WithHTTPClient injects a custom HTTP client. The client is copied before
+// other options are applied, so client itself is left unchanged.
 func WithHTTPClient(client *http.Client) Option {
 	return func(cfg *Config) {
 		cfg.HTTPClient = client
@@ -75,6 +144,170 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithOperationBudget bounds every request, including all retries and backoff
+// delays, to d in total, failing with ErrOperationTimeout when it runs out.
+// Unlike WithTimeout, which limits each attempt, it caps the worst-case
+// latency of one logical operation. Each page of a list helper gets its own
+// budget.
+func WithOperationBudget(d time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.OperationBudget = d
+	}
+}
+
+// WithResponseHeaderTimeout fails a request attempt when the server does not
+// start responding within timeout, independent of the overall client timeout.
+func WithResponseHeaderTimeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ResponseHeaderTimeout = timeout
+	}
+}
+
+// WithMaxResponseHeaderBytes caps the size of response headers accepted from
+// the server. Clients built by this package default to 1 MiB; a custom
+// HTTPClient keeps its own transport setting unless this option is given.
+func WithMaxResponseHeaderBytes(n int64) Option {
+	return func(cfg *Config) {
+		cfg.MaxResponseHeaderBytes = n
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent on every request, for example
+// to identify a service in Cloudflare audit logs. An empty value sends Go's
+// default User-Agent.
+func WithUserAgent(ua string) Option {
+	return func(cfg *Config) {
+		cfg.UserAgent = strings.TrimSpace(ua)
+	}
+}
+
+// WithAPIVersion pins the dated Cloudflare API version, in YYYY-MM-DD form,
+// sent on every request so versioned endpoints keep stable behavior. New
+// rejects a malformed date.
+func WithAPIVersion(date string) Option {
+	return func(cfg *Config) {
+		cfg.APIVersion = strings.TrimSpace(date)
+	}
+}
+
+// WithGlobalAPIKey authenticates with a legacy email and Global API Key pair,
+// sent as X-Auth-Email and X-Auth-Key, instead of a bearer token. Pass an empty
+// token to New when using it.
+func WithGlobalAPIKey(email string, key string) Option {
+	return func(cfg *Config) {
+		cfg.globalAPIEmail = strings.TrimSpace(email)
+		cfg.globalAPIKey = strings.TrimSpace(key)
+	}
+}
+
+// WithJSONMarshaler encodes JSON request bodies with marshal instead of
+// encoding/json, for example to plug in a faster codec.
+func WithJSONMarshaler(marshal func(v any) ([]byte, error)) Option {
+	return func(cfg *Config) {
+		cfg.JSONMarshal = marshal
+	}
+}
+
+// WithJSONUnmarshaler decodes JSON responses with unmarshal instead of
+// encoding/json.
+func WithJSONUnmarshaler(unmarshal func(data []byte, v any) error) Option {
+	return func(cfg *Config) {
+		cfg.JSONUnmarshal = unmarshal
+	}
+}
+
+// WithRedirectPolicy overrides how redirects are followed. By default only
+// same-host redirects are followed so the API token never leaves Cloudflare.
+func WithRedirectPolicy(policy func(req *http.Request, via []*http.Request) error) Option {
+	return func(cfg *Config) {
+		cfg.RedirectPolicy = policy
+	}
+}
+
+// WithHTTPTrace attaches the ClientTrace returned by newTrace to each request
+// attempt, exposing DNS, connect, TLS, and first-byte timings. Disabled by default.
+func WithHTTPTrace(newTrace func(ctx context.Context) *httptrace.ClientTrace) Option {
+	return func(cfg *Config) {
+		cfg.HTTPTrace = newTrace
+	}
+}
+
+// WithTraceContextPropagation sends the W3C traceparent and tracestate headers
+// of the span context stored with tracecontext.NewContext on every request.
+func WithTraceContextPropagation() Option {
+	return func(cfg *Config) {
+		cfg.TraceContextPropagation = true
+	}
+}
+
+// WithCurlLogging writes a curl equivalent of every request attempt to w, with
+// credentials redacted, so failed calls can be reproduced by hand.
+func WithCurlLogging(w io.Writer) Option {
+	return func(cfg *Config) {
+		cfg.CurlLog = w
+	}
+}
+
+// WithRequestCoalescing makes concurrent identical GET requests share a single
+// in-flight request and its result.
+func WithRequestCoalescing() Option {
+	return func(cfg *Config) {
+		cfg.RequestCoalescing = true
+	}
+}
+
+// WithRateLimitAwareness delays requests once a response reports
+// X-RateLimit-Remaining of zero, until the X-RateLimit-Reset time, so bursty
+// callers wait for the window instead of tripping 429s. Each wait is capped at
+// the retry max delay.
+func WithRateLimitAwareness() Option {
+	return func(cfg *Config) {
+		cfg.RateLimitAwareness = true
+	}
+}
+
+// WithMaxConcurrentRetries limits how many requests may wait in retry backoff
+// at once across the client. A request that would retry while n others are
+// already backing off fails immediately with its last error, bounding the
+// goroutines parked during an outage. Zero, the default, means no limit.
+func WithMaxConcurrentRetries(n int) Option {
+	return func(cfg *Config) {
+		cfg.MaxConcurrentRetries = n
+	}
+}
+
+// WithParallelPagination makes list helpers fetch the pages after the first
+// with up to workers concurrent requests once the first page reports
+// total_pages, assembling results in page order. Pages are requested a window
+// of workers at a time and the walk stops at the first empty page. Lists
+// reporting more than 1000 pages and cursor-paginated endpoints are walked
+// sequentially. Each request still honors rate limit awareness, retries, and
+// context cancellation.
+func WithParallelPagination(workers int) Option {
+	return func(cfg *Config) {
+		cfg.ParallelPagination = workers
+	}
+}
+
+// WithResponseInspector calls inspect with the response and body of every
+// attempt, including error responses and ones that will be retried, before
+// the body is decoded, for example to log CF-Ray headers. The body has already
+// been read and closed. A panic in inspect is recovered and ignored.
+func WithResponseInspector(inspect func(resp *http.Response, body []byte)) Option {
+	return func(cfg *Config) {
+		cfg.ResponseInspector = inspect
+	}
+}
+
+// WithLogger logs each request attempt and scheduled retry at debug level with
+// the method, URL path, status, attempt number, duration, and backoff delay.
+// Tokens, query strings, and bodies are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(cfg *Config) {
+		cfg.Logger = logger
+	}
+}
+
 // WithRetries sets retry count and backoff parameters.
 func WithRetries(maxRetries int, baseDelay, maxDelay time.Duration) Option {
 	return func(cfg *Config) {
@@ -84,6 +317,13 @@ func WithRetries(maxRetries int, baseDelay, maxDelay time.Duration) Option {
 	}
 }
 
+// WithMaxRequestBytes caps the marshaled request body size.
+func WithMaxRequestBytes(n int64) Option {
+	return func(cfg *Config) {
+		cfg.MaxRequestBytes = n
+	}
+}
+
 // WithRetryUnsafeMethods allows retries for non-idempotent methods on this request.
 func WithRetryUnsafeMethods() RequestOption {
 	return func(cfg *requestConfig) {
@@ -91,49 +331,149 @@ func WithRetryUnsafeMethods() RequestOption {
 	}
 }
 
+// WithIdempotencyKey sends key as the Idempotency-Key header on every attempt
+// of this request. Without it, or an Idempotency-Key set with WithHeader,
+// requests using WithRetryUnsafeMethods on POST or PATCH get a generated key so
+// retried creates can be deduplicated.
+//
+// Request identity headers are sticky: the Idempotency-Key, X-Request-ID, and
+// WithHeader values are computed once per logical request and sent unchanged
+// on every retry attempt.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.idempotencyKey = strings.TrimSpace(key)
+	}
+}
+
+// WithRequestID sends id as the X-Request-ID header on every attempt of this
+// request, so retries can be correlated with the original attempt in logs.
+func WithRequestID(id string) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.requestID = strings.TrimSpace(id)
+	}
+}
+
+// WithHeader adds a header to every attempt of this request, for endpoints or
+// beta features that need one such as CF-Access-Client-Id. Repeated WithHeader
+// options accumulate, and values for the same key are all sent. Headers set by
+// the client, such as User-Agent, are replaced. The credential headers and
+// Content-Type are reserved: a request that sets them fails.
+func WithHeader(key, value string) RequestOption {
+	return func(cfg *requestConfig) {
+		if cfg.headers == nil {
+			cfg.headers = http.Header{}
+		}
+		cfg.headers.Add(key, value)
+	}
+}
+
+// WithMaxPages limits list methods to fetching at most n pages. Values <= 0
+// fetch every page.
+func WithMaxPages(n int) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.maxPages = n
+	}
+}
+
+// WithSinglePage limits list methods to fetching only the first page.
+func WithSinglePage() RequestOption {
+	return WithMaxPages(1)
+}
+
 func defaultConfig() Config {
 	maxRetries := getenvInt(defaultMaxRetriesEnv, defaultMaxRetries)
 	baseDelaySeconds := getenvFloat(defaultRetryBaseDelayEnv, defaultRetryBaseDelay.Seconds())
 	maxDelaySeconds := getenvFloat(defaultRetryMaxDelayEnv, defaultRetryMaxDelay.Seconds())
 
 	return Config{
-		BaseURL:        defaultBaseURL,
-		Timeout:        httpx.DefaultTimeout,
-		MaxRetries:     maxRetries,
-		RetryBaseDelay: time.Duration(baseDelaySeconds * float64(time.Second)),
-		RetryMaxDelay:  time.Duration(maxDelaySeconds * float64(time.Second)),
+		BaseURL:         defaultBaseURL,
+		Timeout:         httpx.DefaultTimeout,
+		MaxRetries:      maxRetries,
+		RetryBaseDelay:  time.Duration(baseDelaySeconds * float64(time.Second)),
+		RetryMaxDelay:   time.Duration(maxDelaySeconds * float64(time.Second)),
+		MaxRequestBytes: defaultMaxRequestBytes,
+		UserAgent:       httpx.DefaultUserAgent(),
 	}
 }
 
 // Client is a retry-aware Cloudflare API client.
 type Client struct {
-	token string
-	cfg   Config
+	token    string
+	email    string
+	apiKey   string
+	cfg      Config
+	inflight singleflight.Group
+	stats    httpx.Stats
+
+	// rateLimitedUntil is the UnixNano time before which requests wait when
+	// RateLimitAwareness is enabled.
+	rateLimitedUntil atomic.Int64
+	// retrySlots holds one token per request in retry backoff when
+	// MaxConcurrentRetries is set.
+	retrySlots chan struct{}
+}
+
+// ClientStats is a snapshot of a client's request, retry, failure, and
+// per-status-class counters.
+type ClientStats = httpx.ClientStats
+
+// Stats returns the client's request counters. A request counts as a failure
+// when it gets no 2xx response after any retries; a 2xx response whose
+// envelope reports success false is returned as an APIError but not counted.
+func (c *Client) Stats() ClientStats {
+	return c.stats.Snapshot()
+}
+
+// ResetStats sets the client's request counters back to zero.
+func (c *Client) ResetStats() {
+	c.stats.Reset()
 }
 
-// NewFromEnv creates a Cloudflare client using CLOUDFLARE_API_TOKEN.
+// NewFromEnv creates a Cloudflare client using CLOUDFLARE_API_TOKEN, falling
+// back to CLOUDFLARE_EMAIL and CLOUDFLARE_API_KEY when no token is set.
 func NewFromEnv(opts ...Option) (*Client, error) {
 	token := strings.TrimSpace(os.Getenv(defaultTokenEnv))
-	if token == "" {
-		return nil, fmt.Errorf("%s is required", defaultTokenEnv)
+	if token != "" {
+		return New(token, opts...)
 	}
-	return New(token, opts...)
-}
 
-// New creates a Cloudflare client from an explicit API token.
-func New(token string, opts ...Option) (*Client, error) {
-	if strings.TrimSpace(token) == "" {
-		return nil, errors.New("cloudflare API token must be provided")
+	email := strings.TrimSpace(os.Getenv(defaultEmailEnv))
+	key := strings.TrimSpace(os.Getenv(defaultAPIKeyEnv))
+	if email == "" || key == "" {
+		return nil, fmt.Errorf("%s, or %s and %s, is required", defaultTokenEnv, defaultEmailEnv, defaultAPIKeyEnv)
 	}
+	return New("", append(slices.Clip(opts), WithGlobalAPIKey(email, key))...)
+}
 
+// New creates a Cloudflare client from an explicit API token, or from an empty
+// token together with WithGlobalAPIKey.
+func New(token string, opts ...Option) (*Client, error) {
 	cfg := defaultConfig()
 	for _, opt := range opts {
 		opt(&cfg)
 	}
 
+	email, apiKey := cfg.globalAPIEmail, cfg.globalAPIKey
+	cfg.globalAPIEmail, cfg.globalAPIKey = "", ""
+	hasToken := strings.TrimSpace(token) != ""
+	hasGlobalKey := email != "" || apiKey != ""
+	switch {
+	case hasToken && hasGlobalKey:
+		return nil, errors.New("cloudflare API token and global API key are mutually exclusive")
+	case hasGlobalKey && (email == "" || apiKey == ""):
+		return nil, errors.New("cloudflare global API key requires both email and key")
+	case !hasToken && !hasGlobalKey:
+		return nil, errors.New("cloudflare API token must be provided")
+	}
+
 	if cfg.BaseURL == "" {
 		cfg.BaseURL = defaultBaseURL
 	}
+	if cfg.APIVersion != "" {
+		if _, err := time.Parse(apiVersionLayout, cfg.APIVersion); err != nil {
+			return nil, fmt.Errorf("cloudflare API version must be a YYYY-MM-DD date, got %q", cfg.APIVersion)
+		}
+	}
 	if cfg.Timeout <= 0 {
 		cfg.Timeout = httpx.DefaultTimeout
 	}
@@ -146,22 +486,60 @@ func New(token string, opts ...Option) (*Client, error) {
 	if cfg.RetryMaxDelay <= 0 {
 		cfg.RetryMaxDelay = defaultRetryMaxDelay
 	}
+	if cfg.MaxRequestBytes <= 0 {
+		cfg.MaxRequestBytes = defaultMaxRequestBytes
+	}
 	if cfg.HTTPClient == nil {
 		cfg.HTTPClient = httpx.NewClient(cfg.Timeout)
-	} else if cfg.HTTPClient.Timeout <= 0 {
-		cfg.HTTPClient.Timeout = cfg.Timeout
+	} else {
+		// Settings below are applied to a copy so a caller's client, which
+		// may be shared with other clients, is never modified.
+		cfg.HTTPClient = httpx.CopyClient(cfg.HTTPClient)
+		if cfg.HTTPClient.Timeout <= 0 {
+			cfg.HTTPClient.Timeout = cfg.Timeout
+		}
 	}
+	httpx.ApplyRedirectPolicy(cfg.HTTPClient, cfg.RedirectPolicy)
+	if err := httpx.ApplyResponseHeaderTimeout(cfg.HTTPClient, cfg.ResponseHeaderTimeout); err != nil {
+		return nil, err
+	}
+	if err := httpx.ApplyMaxResponseHeaderBytes(cfg.HTTPClient, cfg.MaxResponseHeaderBytes); err != nil {
+		return nil, err
+	}
+	if cfg.TraceContextPropagation {
+		cfg.HTTPClient.Transport = httpx.NewTraceContextTransport(cfg.HTTPClient.Transport)
+	}
+
+	client := &Client{
+		token:  token,
+		email:  email,
+		apiKey: apiKey,
+		cfg:    cfg,
+	}
+	if cfg.MaxConcurrentRetries > 0 {
+		client.retrySlots = make(chan struct{}, cfg.MaxConcurrentRetries)
+	}
+	return client, nil
+}
 
-	return &Client{
-		token: token,
-		cfg:   cfg,
-	}, nil
+// Config returns a copy of the effective client configuration. The API token
+// and Global API Key are not part of Config, so the result is safe to log.
+func (c *Client) Config() Config {
+	return c.cfg
 }
 
 // HTTPStatusError captures non-2xx responses returned by Cloudflare.
 type HTTPStatusError struct {
 	StatusCode int
 	Body       string
+	// RawBody holds the undecoded response body for callers that need to
+	// re-decode structured error payloads.
+	RawBody []byte
+	// Errors holds Cloudflare API errors parsed from the body, when present.
+	Errors []APIErrorItem
+	// RetryReasons lists why each preceding attempt was retried, in order,
+	// e.g. "transport:timeout" or "status:503".
+	RetryReasons []string
 }
 
 // Error implements the error interface.
@@ -172,6 +550,38 @@ func (e *HTTPStatusError) Error() string {
 	return fmt.Sprintf("cloudflare request failed with status %d: %s", e.StatusCode, e.Body)
 }
 
+// Is reports a 401 as autherr.ErrUnauthorized and a 403 as
+// autherr.ErrForbidden, and both as ErrUnauthorized, so credential failures
+// can be detected with errors.Is.
+func (e *HTTPStatusError) Is(target error) bool {
+	if target == ErrUnauthorized {
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	}
+	sentinel := autherr.FromStatus(e.StatusCode)
+	return sentinel != nil && target == sentinel
+}
+
+// APIError is returned when Cloudflare answers with a 2xx status but an
+// unsuccessful envelope. Errors holds the API error items.
+type APIError struct {
+	Errors []APIErrorItem
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return "cloudflare API returned unsuccessful response: " + formatAPIErrors(e.Errors)
+}
+
+// Codes returns the Cloudflare error codes in response order, for branching
+// on specific failures such as 10000 (authentication).
+func (e *APIError) Codes() []int {
+	codes := make([]int, 0, len(e.Errors))
+	for _, item := range e.Errors {
+		codes = append(codes, item.Code)
+	}
+	return codes
+}
+
 // Do executes a Cloudflare API request and unmarshals result into out.
 func (c *Client) Do(
 	ctx context.Context,
@@ -203,13 +613,32 @@ func (c *Client) DoWithOptions(
 		return nil
 	}
 
-	if err := json.Unmarshal(env.Result, out); err != nil {
+	if err := c.unmarshalJSON(env.Result, out); err != nil {
 		return fmt.Errorf("decode cloudflare result: %w", err)
 	}
 
 	return nil
 }
 
+// DoTyped executes a Cloudflare API request and returns the result decoded as
+// T. A missing or null result returns the zero value of T.
+func DoTyped[T any](
+	ctx context.Context,
+	c *Client,
+	method string,
+	endpoint string,
+	params url.Values,
+	requestBody any,
+	reqOpts ...RequestOption,
+) (T, error) {
+	var out T
+	if err := c.DoWithOptions(ctx, method, endpoint, params, requestBody, &out, reqOpts...); err != nil {
+		var zero T
+		return zero, err
+	}
+	return out, nil
+}
+
 // Raw executes a Cloudflare API request against an arbitrary endpoint.
 func (c *Client) Raw(
 	ctx context.Context,
@@ -223,6 +652,37 @@ func (c *Client) Raw(
 	return c.DoWithOptions(ctx, method, endpoint, params, requestBody, out, reqOpts...)
 }
 
+// DoRaw executes a Cloudflare API request and returns the undecoded result
+// with its pagination metadata, so callers can follow pages or cursors
+// themselves.
+func (c *Client) DoRaw(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	params url.Values,
+	requestBody any,
+	reqOpts ...RequestOption,
+) (*Response, error) {
+	env, err := c.doEnvelope(ctx, method, endpoint, params, requestBody, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &Response{Result: env.Result, ResultInfo: env.ResultInfo}
+	if env.ResultInfo != nil && env.ResultInfo.Cursors != nil {
+		resp.NextCursor = env.ResultInfo.Cursors.After
+	}
+	return resp, nil
+}
+
+// multipartBody is a pre-encoded request body sent with its own content type
+// instead of being marshaled as JSON.
+type multipartBody struct {
+	contentType string
+	data        []byte
+}
+
+// doEnvelope runs doRequest within the configured operation budget.
 func (c *Client) doEnvelope(
 	ctx context.Context,
 	method string,
@@ -231,34 +691,199 @@ func (c *Client) doEnvelope(
 	requestBody any,
 	reqOpts ...RequestOption,
 ) (*envelope, error) {
+	if c.cfg.OperationBudget <= 0 {
+		return c.doRequest(ctx, method, endpoint, params, requestBody, reqOpts...)
+	}
+
+	budgetCtx, cancel := context.WithTimeoutCause(ctx, c.cfg.OperationBudget, ErrOperationTimeout)
+	defer cancel()
+	env, err := c.doRequest(budgetCtx, method, endpoint, params, requestBody, reqOpts...)
+	if err != nil && ctx.Err() == nil && errors.Is(context.Cause(budgetCtx), ErrOperationTimeout) {
+		return nil, fmt.Errorf("%w after %s: %w", ErrOperationTimeout, c.cfg.OperationBudget, err)
+	}
+	return env, err
+}
+
+func (c *Client) doRequest(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	params url.Values,
+	requestBody any,
+	reqOpts ...RequestOption,
+) (*envelope, error) {
+	cfg := requestConfig{}
+	for _, opt := range reqOpts {
+		opt(&cfg)
+	}
+	if err := checkRequestHeaders(cfg.headers); err != nil {
+		return nil, err
+	}
+
+	optionParams := url.Values{}
+	if cfg.listOptions != nil {
+		listParams, err := cfg.listOptions.ToValues()
+		if err != nil {
+			return nil, err
+		}
+		optionParams = listParams
+	}
+	if cfg.perPage > 0 {
+		optionParams.Set("per_page", strconv.Itoa(cfg.perPage))
+	}
+	if len(optionParams) > 0 {
+		params = mergeParams(optionParams, params)
+	}
+
 	targetURL, err := c.buildURL(endpoint, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var payload []byte
-	if requestBody != nil {
-		payload, err = json.Marshal(requestBody)
+	var pooled *pooledBody
+	contentType := "application/json"
+	if form, ok := requestBody.(multipartBody); ok {
+		payload = form.data
+		contentType = form.contentType
+	} else if requestBody != nil && c.cfg.JSONMarshal != nil {
+		payload, err = c.cfg.JSONMarshal(requestBody)
+		if err != nil {
+			return nil, fmt.Errorf("marshal request body: %w", err)
+		}
+	} else if requestBody != nil {
+		pooled, err = encodePooledBody(requestBody)
 		if err != nil {
 			return nil, fmt.Errorf("marshal request body: %w", err)
 		}
+		defer pooled.release()
+		payload = pooled.Bytes()
 	}
-
-	cfg := requestConfig{}
-	for _, opt := range reqOpts {
-		opt(&cfg)
+	if int64(len(payload)) > c.cfg.MaxRequestBytes {
+		return nil, fmt.Errorf(
+			"%w: %d bytes exceeds limit of %d bytes",
+			ErrRequestTooLarge,
+			len(payload),
+			c.cfg.MaxRequestBytes,
+		)
 	}
 
 	retryableMethod := shouldRetryMethod(method, cfg.retryUnsafeMethods)
+	headers := cfg.headers.Clone()
+	idempotencyKey := cfg.idempotencyKey
+	if idempotencyKey == "" {
+		// A key supplied with WithHeader is kept instead of being replaced.
+		idempotencyKey = headers.Get("Idempotency-Key")
+	}
+	if idempotencyKey == "" && cfg.retryUnsafeMethods && !shouldRetryMethod(method, false) {
+		// One key per logical request, shared by every attempt, lets the server
+		// discard duplicates of a create that succeeded before a retry.
+		idempotencyKey = newIdempotencyKey()
+	}
+	// Identity headers are fixed here, once per logical request, so every
+	// retry attempt built by newRequest carries the same values.
+	for key, value := range map[string]string{"Idempotency-Key": idempotencyKey, "X-Request-ID": cfg.requestID} {
+		if value == "" {
+			continue
+		}
+		if headers == nil {
+			headers = http.Header{}
+		}
+		headers.Set(key, value)
+	}
+	if c.cfg.RequestCoalescing && method == http.MethodGet && payload == nil && len(headers) == 0 {
+		return c.sendCoalesced(ctx, targetURL, retryableMethod)
+	}
+	return c.send(ctx, method, targetURL, payload, pooled, contentType, retryableMethod, headers)
+}
+
+// reservedHeaders are set by the client and cannot be supplied with WithHeader.
+var reservedHeaders = []string{"Authorization", "X-Auth-Email", "X-Auth-Key", "Content-Type"}
+
+func checkRequestHeaders(headers http.Header) error {
+	for _, key := range reservedHeaders {
+		if _, ok := headers[key]; ok {
+			return fmt.Errorf("header %s is reserved and cannot be set per request", key)
+		}
+	}
+	return nil
+}
+
+// sendCoalesced shares one in-flight GET between concurrent callers of the same
+// URL. The shared request is detached from the first caller's cancellation so
+// it cannot fail the others; each caller still stops waiting when its own
+// context ends.
+func (c *Client) sendCoalesced(ctx context.Context, targetURL string, retryableMethod bool) (*envelope, error) {
+	key := http.MethodGet + " " + targetURL
+	results := c.inflight.DoChan(key, func() (any, error) {
+		return c.send(context.WithoutCancel(ctx), http.MethodGet, targetURL, nil, nil, "application/json", retryableMethod, nil)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*envelope), nil
+	}
+}
+
+func (c *Client) send(
+	ctx context.Context,
+	method string,
+	targetURL string,
+	payload []byte,
+	pooled *pooledBody,
+	contentType string,
+	retryableMethod bool,
+	headers http.Header,
+) (*envelope, error) {
+	c.stats.RecordRequest()
+	bodyBytes, err := c.sendAttempts(ctx, method, targetURL, payload, pooled, contentType, retryableMethod, headers)
+	if err != nil {
+		c.stats.RecordFailure()
+		return nil, err
+	}
+
+	var env envelope
+	if err := c.unmarshalJSON(bodyBytes, &env); err != nil {
+		return nil, fmt.Errorf("decode cloudflare envelope: %w", err)
+	}
+	if !env.Success {
+		return nil, &APIError{Errors: env.Errors}
+	}
+	return &env, nil
+}
+
+// sendAttempts makes the attempts of one request and returns the body of its
+// final 2xx response.
+func (c *Client) sendAttempts(
+	ctx context.Context,
+	method string,
+	targetURL string,
+	payload []byte,
+	pooled *pooledBody,
+	contentType string,
+	retryableMethod bool,
+	headers http.Header,
+) ([]byte, error) {
+	var retryReasons []string
 
 	for attempt := 0; ; attempt++ {
-		req, reqErr := c.newRequest(ctx, method, targetURL, payload)
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		req, reqErr := c.newRequest(ctx, method, targetURL, payload, pooled, contentType, headers)
 		if reqErr != nil {
 			return nil, reqErr
 		}
 
+		started := time.Now()
 		resp, doErr := c.cfg.HTTPClient.Do(req)
 		if doErr != nil {
+			httpx.LogAttempt(ctx, c.cfg.Logger, method, targetURL, attempt, 0, time.Since(started), doErr)
 			if !retryableMethod || attempt >= c.cfg.MaxRetries {
 				return nil, fmt.Errorf("cloudflare request failed after retries: %w", doErr)
 			}
@@ -269,75 +894,66 @@ func (c *Client) doEnvelope(
 				true,
 				secureRandomUnitFloat64(),
 			)
-			if sleepErr := httpx.SleepContext(ctx, delay); sleepErr != nil {
+			reason := transportRetryReason(doErr)
+			httpx.LogRetry(ctx, c.cfg.Logger, method, targetURL, attempt, delay, reason)
+			retried, sleepErr := c.backoff(ctx, delay)
+			if sleepErr != nil {
 				return nil, sleepErr
 			}
+			if !retried {
+				return nil, fmt.Errorf("cloudflare request failed, retry limit reached: %w", doErr)
+			}
+			retryReasons = append(retryReasons, reason)
 			continue
 		}
 
+		c.stats.RecordStatus(resp.StatusCode)
+		c.observeRateLimit(resp.Header)
 		bodyBytes, readErr := io.ReadAll(resp.Body)
 		_ = resp.Body.Close()
+		httpx.LogAttempt(ctx, c.cfg.Logger, method, targetURL, attempt, resp.StatusCode, time.Since(started), readErr)
 		if readErr != nil {
 			return nil, fmt.Errorf("read cloudflare response body: %w", readErr)
 		}
+		c.inspectResponse(resp, bodyBytes)
 
 		if shouldRetryStatus(resp.StatusCode) && retryableMethod && attempt < c.cfg.MaxRetries {
 			delay := c.retryDelay(attempt, resp.Header.Get("Retry-After"))
-			if sleepErr := httpx.SleepContext(ctx, delay); sleepErr != nil {
+			reason := fmt.Sprintf("status:%d", resp.StatusCode)
+			httpx.LogRetry(ctx, c.cfg.Logger, method, targetURL, attempt, delay, reason)
+			retried, sleepErr := c.backoff(ctx, delay)
+			if sleepErr != nil {
 				return nil, sleepErr
 			}
-			continue
+			if retried {
+				retryReasons = append(retryReasons, reason)
+				continue
+			}
 		}
 
 		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 			return nil, &HTTPStatusError{
-				StatusCode: resp.StatusCode,
-				Body:       string(bodyBytes),
+				StatusCode:   resp.StatusCode,
+				Body:         string(bodyBytes),
+				RawBody:      bodyBytes,
+				Errors:       c.parseAPIErrors(bodyBytes),
+				RetryReasons: retryReasons,
 			}
 		}
 
-		var env envelope
-		if err := json.Unmarshal(bodyBytes, &env); err != nil {
-			return nil, fmt.Errorf("decode cloudflare envelope: %w", err)
-		}
-
-		if !env.Success {
-			return nil, fmt.Errorf("cloudflare API returned unsuccessful response: %s", formatAPIErrors(env.Errors))
-		}
-
-		return &env, nil
+		return bodyBytes, nil
 	}
 }
 
 // ListZones lists zones visible to the authenticated token.
-func (c *Client) ListZones(ctx context.Context) ([]Zone, error) {
-	var allZones []Zone
-	page := 1
-
-	for {
-		params := url.Values{}
-		params.Set("page", strconv.Itoa(page))
-
-		env, err := c.doEnvelope(ctx, http.MethodGet, "/zones", params, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		var pageZones []Zone
-		if len(env.Result) > 0 && string(env.Result) != "null" {
-			if err := json.Unmarshal(env.Result, &pageZones); err != nil {
-				return nil, fmt.Errorf("decode cloudflare zone list: %w", err)
-			}
-		}
-		allZones = append(allZones, pageZones...)
-
-		if env.ResultInfo == nil || env.ResultInfo.TotalPages <= page {
-			break
-		}
-		page++
-	}
+func (c *Client) ListZones(ctx context.Context, reqOpts ...RequestOption) ([]Zone, error) {
+	return listAllPages[Zone](ctx, c, "/zones", nil, "zone list", reqOpts...)
+}
 
-	return allZones, nil
+// ListZonesWithInfo lists zones like ListZones and also returns the last
+// page's ResultInfo, whose TotalCount is the number of zones across all pages.
+func (c *Client) ListZonesWithInfo(ctx context.Context, reqOpts ...RequestOption) ([]Zone, *ResultInfo, error) {
+	return listAllPagesWithInfo[Zone](ctx, c, "/zones", nil, "zone list", reqOpts...)
 }
 
 // ZoneIDByName resolves a zone name to its Cloudflare zone ID.
@@ -367,38 +983,103 @@ func (c *Client) buildURL(endpoint string, params url.Values) (string, error) {
 		return "", fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	cleanEndpoint := endpoint
+	cleanEndpoint, endpointQuery, hasQuery := strings.Cut(endpoint, "?")
 	if !strings.HasPrefix(cleanEndpoint, "/") {
 		cleanEndpoint = "/" + cleanEndpoint
 	}
 
-	base.Path = strings.TrimRight(base.Path, "/") + cleanEndpoint
-	if params != nil {
+	// Endpoints carry path segments already escaped with url.PathEscape, so
+	// keep them as the raw path rather than escaping them a second time.
+	rawPath := strings.TrimRight(base.EscapedPath(), "/") + cleanEndpoint
+	unescaped, err := url.PathUnescape(rawPath)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint path %q: %w", cleanEndpoint, err)
+	}
+	base.Path = unescaped
+	base.RawPath = rawPath
+	switch {
+	case hasQuery && params != nil:
+		// A query embedded in the endpoint is kept, with params winning on
+		// conflicting keys.
+		embedded, err := url.ParseQuery(endpointQuery)
+		if err != nil {
+			return "", fmt.Errorf("invalid endpoint query %q: %w", endpoint, err)
+		}
+		base.RawQuery = mergeParams(embedded, params).Encode()
+	case hasQuery:
+		base.RawQuery = endpointQuery
+	case params != nil:
 		base.RawQuery = params.Encode()
 	}
 
 	return base.String(), nil
 }
 
-func (c *Client) newRequest(ctx context.Context, method, targetURL string, payload []byte) (*http.Request, error) {
+func (c *Client) newRequest(
+	ctx context.Context,
+	method string,
+	targetURL string,
+	payload []byte,
+	pooled *pooledBody,
+	contentType string,
+	headers http.Header,
+) (*http.Request, error) {
 	var body io.Reader
-	if payload != nil {
+	switch {
+	case pooled != nil:
+		body = pooled.newReader()
+	case payload != nil:
 		body = bytes.NewReader(payload)
 	}
 
+	if c.cfg.HTTPTrace != nil {
+		if trace := c.cfg.HTTPTrace(ctx); trace != nil {
+			ctx = httptrace.WithClientTrace(ctx, trace)
+		}
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, targetURL, body)
 	if err != nil {
+		if closer, ok := body.(io.Closer); ok {
+			_ = closer.Close()
+		}
 		return nil, fmt.Errorf("create cloudflare request: %w", err)
 	}
+	if pooled != nil {
+		req.ContentLength = int64(len(payload))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return pooled.newReader(), nil
+		}
+	}
 
-	req.Header.Set("Authorization", "Bearer "+c.token)
-	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("X-Auth-Email", c.email)
+		req.Header.Set("X-Auth-Key", c.apiKey)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if c.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	if c.cfg.APIVersion != "" {
+		req.Header.Set(headerAPIVersion, c.cfg.APIVersion)
+	}
+	for key, values := range headers {
+		req.Header[key] = append([]string(nil), values...)
+	}
+	if c.cfg.CurlLog != nil {
+		c.writeCurl(c.cfg.CurlLog, req, payload)
+	}
 	return req, nil
 }
 
+// retryDelay honors a Retry-After header, capped at RetryMaxDelay so an
+// aggressive upstream value cannot stall a request, and otherwise backs off
+// exponentially.
 func (c *Client) retryDelay(attempt int, retryAfterHeader string) time.Duration {
 	if delay, ok := parseRetryAfter(retryAfterHeader); ok {
-		return delay
+		return min(delay, c.cfg.RetryMaxDelay)
 	}
 
 	return httpx.ExponentialBackoffDelay(
@@ -419,6 +1100,20 @@ func shouldRetryMethod(method string, retryUnsafe bool) bool {
 	}
 }
 
+func transportRetryReason(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "transport:timeout"
+	case errors.Is(err, syscall.ECONNRESET):
+		return "transport:connection_reset"
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return "transport:connection_refused"
+	default:
+		return "transport:error"
+	}
+}
+
 func shouldRetryStatus(statusCode int) bool {
 	return statusCode == http.StatusRequestTimeout ||
 		statusCode == http.StatusTooManyRequests ||
@@ -450,6 +1145,110 @@ func parseRetryAfter(value string) (time.Duration, bool) {
 	return delay, true
 }
 
+// inspectResponse passes a response to the configured inspector, shielding
+// the request from a panicking inspector.
+func (c *Client) inspectResponse(resp *http.Response, body []byte) {
+	if c.cfg.ResponseInspector == nil {
+		return
+	}
+	defer func() {
+		_ = recover()
+	}()
+	c.cfg.ResponseInspector(resp, body)
+}
+
+// backoff sleeps for delay before a retry. With WithMaxConcurrentRetries it
+// first claims a retry slot and reports false, without sleeping, when every
+// slot is taken so the caller fails fast.
+func (c *Client) backoff(ctx context.Context, delay time.Duration) (bool, error) {
+	if c.retrySlots != nil {
+		select {
+		case c.retrySlots <- struct{}{}:
+			defer func() { <-c.retrySlots }()
+		default:
+			return false, nil
+		}
+	}
+	if err := httpx.SleepContext(ctx, delay); err != nil {
+		return false, err
+	}
+	c.stats.RecordRetry()
+	return true, nil
+}
+
+// observeRateLimit records when requests may resume after a response reports
+// an exhausted rate limit window.
+func (c *Client) observeRateLimit(header http.Header) {
+	if !c.cfg.RateLimitAwareness || strings.TrimSpace(header.Get("X-RateLimit-Remaining")) != "0" {
+		return
+	}
+	delay, ok := parseRateLimitReset(header.Get("X-RateLimit-Reset"), time.Now())
+	if !ok || delay <= 0 {
+		return
+	}
+	delay = min(delay, c.cfg.RetryMaxDelay)
+
+	until := time.Now().Add(delay).UnixNano()
+	for {
+		current := c.rateLimitedUntil.Load()
+		if current >= until || c.rateLimitedUntil.CompareAndSwap(current, until) {
+			return
+		}
+	}
+}
+
+// waitForRateLimit sleeps until a recorded rate limit window resets.
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if !c.cfg.RateLimitAwareness {
+		return nil
+	}
+	delay := time.Until(time.Unix(0, c.rateLimitedUntil.Load()))
+	if delay <= 0 {
+		return nil
+	}
+	return httpx.SleepContext(ctx, delay)
+}
+
+// parseRateLimitReset converts X-RateLimit-Reset, either seconds until the
+// reset or a Unix timestamp in seconds, to a delay from now.
+func parseRateLimitReset(value string, now time.Time) (time.Duration, bool) {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	// Values this large cannot be relative delays, so treat them as epochs.
+	if seconds >= 1_000_000_000 {
+		return time.Unix(seconds, 0).Sub(now), true
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// marshalJSON encodes v with the configured JSON marshaler.
+func (c *Client) marshalJSON(v any) ([]byte, error) {
+	if c.cfg.JSONMarshal != nil {
+		return c.cfg.JSONMarshal(v)
+	}
+	return json.Marshal(v)
+}
+
+// unmarshalJSON decodes data with the configured JSON unmarshaler.
+func (c *Client) unmarshalJSON(data []byte, v any) error {
+	if c.cfg.JSONUnmarshal != nil {
+		return c.cfg.JSONUnmarshal(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// parseAPIErrors extracts the errors array from a Cloudflare envelope body,
+// returning nil when the body is not a JSON envelope.
+func (c *Client) parseAPIErrors(body []byte) []APIErrorItem {
+	var env envelope
+	if err := c.unmarshalJSON(body, &env); err != nil {
+		return nil
+	}
+	return env.Errors
+}
+
 func formatAPIErrors(items []APIErrorItem) string {
 	if len(items) == 0 {
 		return "unknown API error"
@@ -466,6 +1265,17 @@ func formatAPIErrors(items []APIErrorItem) string {
 	return strings.Join(parts, ", ")
 }
 
+// newIdempotencyKey returns a random version 4 UUID.
+func newIdempotencyKey() string {
+	var raw [16]byte
+	if _, err := crand.Read(raw[:]); err != nil {
+		return ""
+	}
+	raw[6] = raw[6]&0x0f | 0x40
+	raw[8] = raw[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", raw[0:4], raw[4:6], raw[6:8], raw[8:10], raw[10:16])
+}
+
 func secureRandomUnitFloat64() float64 {
 	var raw [8]byte
 	if _, err := crand.Read(raw[:]); err != nil {
@@ -1,15 +1,27 @@
 package cloudflare
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/http/httptrace"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/d-padmanabhan/platform-core-go/autherr"
+	"github.com/d-padmanabhan/platform-core-go/tracecontext"
 )
 
 type roundTripFunc func(*http.Request) (*http.Response, error)
@@ -337,3 +349,1328 @@ func TestDoWithOptions_RetriesUnsafeMethodOnTransportErrorWhenEnabled(t *testing
 		t.Fatalf("unexpected response payload: %#v", out)
 	}
 }
+
+func TestDo_RejectsRequestBodyOverLimit(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithMaxRequestBytes(16))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(
+		context.Background(),
+		http.MethodPut,
+		"/accounts/acc-1/access/apps/app-1",
+		nil,
+		map[string]any{"name": strings.Repeat("x", 64)},
+		nil,
+	)
+	if !errors.Is(err, ErrRequestTooLarge) {
+		t.Fatalf("expected ErrRequestTooLarge, got: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("expected no requests to be sent, got: %d", calls)
+	}
+}
+
+func TestDo_RecordsRetryReasons(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+			calls++
+			switch calls {
+			case 1:
+				return nil, errors.New("temporary transport failure")
+			case 2:
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Header:     make(http.Header),
+					Body:       io.NopCloser(strings.NewReader(`{"success":false}`)),
+				}, nil
+			default:
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{"Retry-After": []string{"0"}},
+					Body:       io.NopCloser(strings.NewReader(`{"success":false}`)),
+				}, nil
+			}
+		}),
+	}
+
+	client, err := New(
+		"token",
+		WithHTTPClient(httpClient),
+		WithRetries(2, time.Millisecond, 2*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected HTTPStatusError, got: %v", err)
+	}
+	if statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("unexpected final status: %d", statusErr.StatusCode)
+	}
+	want := []string{"transport:error", "status:503"}
+	if strings.Join(statusErr.RetryReasons, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected retry reasons: got=%v want=%v", statusErr.RetryReasons, want)
+	}
+}
+
+func TestConfigReturnsEffectiveSettings(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token", WithBaseURL("https://example.com/api/"), WithRetries(-1, 0, 0))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	cfg := client.Config()
+	if cfg.BaseURL != "https://example.com/api" || cfg.MaxRetries != 0 {
+		t.Fatalf("unexpected effective config: %#v", cfg)
+	}
+	if cfg.RetryBaseDelay != defaultRetryBaseDelay || cfg.RetryMaxDelay != defaultRetryMaxDelay {
+		t.Fatalf("expected default retry delays, got: %#v", cfg)
+	}
+}
+
+func TestDo_AttachesHTTPTrace(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	var gotConn, firstByte bool
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithHTTPTrace(func(context.Context) *httptrace.ClientTrace {
+			return &httptrace.ClientTrace{
+				GotConn:              func(httptrace.GotConnInfo) { gotConn = true },
+				GotFirstResponseByte: func() { firstByte = true },
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil); err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+	if !gotConn || !firstByte {
+		t.Fatalf("expected trace hooks to fire: gotConn=%t firstByte=%t", gotConn, firstByte)
+	}
+}
+
+func BenchmarkListZones(b *testing.B) {
+	const (
+		pages   = 20
+		perPage = 50
+	)
+
+	bodies := make(map[string][]byte, pages)
+	for page := 1; page <= pages; page++ {
+		zones := make([]map[string]any, perPage)
+		for i := range zones {
+			zones[i] = map[string]any{"id": "zone", "name": "acme.com"}
+		}
+		body, err := json.Marshal(map[string]any{
+			"success": true,
+			"result":  zones,
+			"result_info": map[string]any{
+				"page":        page,
+				"per_page":    perPage,
+				"total_pages": pages,
+				"count":       perPage,
+				"total_count": pages * perPage,
+			},
+		})
+		if err != nil {
+			b.Fatalf("marshal page: %v", err)
+		}
+		bodies[strconv.Itoa(page)] = body
+	}
+
+	httpClient := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       io.NopCloser(bytes.NewReader(bodies[req.URL.Query().Get("page")])),
+			}, nil
+		}),
+	}
+
+	client, err := New("token", WithHTTPClient(httpClient))
+	if err != nil {
+		b.Fatalf("new client: %v", err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := client.ListZones(context.Background()); err != nil {
+			b.Fatalf("list zones: %v", err)
+		}
+	}
+}
+
+func TestDo_HTTPStatusErrorCarriesAPIErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":10000,"message":"Authentication error"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected HTTPStatusError, got: %v", err)
+	}
+	if len(statusErr.Errors) != 1 || statusErr.Errors[0].Code != 10000 {
+		t.Fatalf("unexpected parsed API errors: %#v", statusErr.Errors)
+	}
+	if !bytes.Contains(statusErr.RawBody, []byte("Authentication error")) {
+		t.Fatalf("unexpected raw body: %s", statusErr.RawBody)
+	}
+}
+
+func TestDo_ResponseHeaderTimeout(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{}}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithRetries(0, time.Millisecond, time.Millisecond),
+		WithResponseHeaderTimeout(20*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	start := time.Now()
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+	if err == nil {
+		t.Fatalf("expected response header timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("expected fast failure, took: %s", elapsed)
+	}
+}
+
+func TestDo_PropagatesTraceContext(t *testing.T) {
+	t.Parallel()
+
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("traceparent") != traceParent {
+			t.Fatalf("unexpected traceparent header: %q", r.Header.Get("traceparent"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTraceContextPropagation())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx, err := tracecontext.NewContext(context.Background(), tracecontext.SpanContext{TraceParent: traceParent})
+	if err != nil {
+		t.Fatalf("new trace context: %v", err)
+	}
+	if err := client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil); err != nil {
+		t.Fatalf("do request: %v", err)
+	}
+}
+
+func TestZoneIDByName_CoalescesConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":[{"id":"zone-1","name":"example.com"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRequestCoalescing())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	const callers = 10
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Go(func() {
+			zoneID, err := client.ZoneIDByName(context.Background(), "example.com")
+			if err == nil && zoneID != "zone-1" {
+				err = errors.New("unexpected zone ID: " + zoneID)
+			}
+			errs <- err
+		})
+	}
+
+	// Give every caller time to join the in-flight request before it completes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("zone lookup: %v", err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 coalesced request, got %d", calls.Load())
+	}
+}
+
+func TestListZonesWithInfo(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  []map[string]any{{"id": "zone-" + strconv.Itoa(page), "name": "example" + strconv.Itoa(page) + ".com"}},
+			"result_info": map[string]any{
+				"page":        page,
+				"per_page":    1,
+				"total_pages": 3,
+				"count":       1,
+				"total_count": 3,
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, info, err := client.ListZonesWithInfo(context.Background())
+	if err != nil {
+		t.Fatalf("list zones with info: %v", err)
+	}
+	if len(zones) != 3 {
+		t.Fatalf("expected 3 zones, got %d", len(zones))
+	}
+	if info == nil || info.TotalCount != 3 || info.Page != 3 {
+		t.Fatalf("unexpected result info: %#v", info)
+	}
+}
+
+func TestDoRaw(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/accounts/acc-1/cfd_tunnel":
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"t-1"}],` +
+				`"result_info":{"per_page":1,"count":1,"cursors":{"after":"c-2"}}}`))
+		case "/zones":
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1"}],` +
+				`"result_info":{"page":1,"per_page":1,"total_pages":4,"total_count":4}}`))
+		default:
+			_, _ = w.Write([]byte(`{"success":true,"result":{"id":"zone-1"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		endpoint       string
+		wantCursor     string
+		wantTotalPages int
+		wantInfo       bool
+	}{
+		{name: "cursor page", endpoint: "/accounts/acc-1/cfd_tunnel", wantCursor: "c-2", wantInfo: true},
+		{name: "numbered page", endpoint: "/zones", wantTotalPages: 4, wantInfo: true},
+		{name: "single object", endpoint: "/zones/zone-1"},
+	}
+
+	for _, tc := range tests {
+		resp, err := client.DoRaw(ctx, http.MethodGet, tc.endpoint, nil, nil)
+		if err != nil {
+			t.Fatalf("%s: do raw: %v", tc.name, err)
+		}
+		if len(resp.Result) == 0 || resp.NextCursor != tc.wantCursor || (resp.ResultInfo != nil) != tc.wantInfo {
+			t.Fatalf("%s: unexpected response: %#v", tc.name, resp)
+		}
+		if tc.wantInfo && resp.ResultInfo.TotalPages != tc.wantTotalPages {
+			t.Fatalf("%s: unexpected result info: %#v", tc.name, resp.ResultInfo)
+		}
+	}
+}
+
+func TestDoTyped(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/zones/zone-1":
+			_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":{"id":"zone-1","name":"example.com"}}`))
+		case "/empty":
+			_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	zone, err := DoTyped[Zone](ctx, client, http.MethodGet, "/zones/zone-1", nil, nil)
+	if err != nil {
+		t.Fatalf("do typed: %v", err)
+	}
+	if zone.Name != "example.com" {
+		t.Fatalf("unexpected zone: %#v", zone)
+	}
+
+	empty, err := DoTyped[*Zone](ctx, client, http.MethodGet, "/empty", nil, nil)
+	if err != nil || empty != nil {
+		t.Fatalf("expected nil zero value for null result, got %#v (err %v)", empty, err)
+	}
+
+	missing, err := DoTyped[Zone](ctx, client, http.MethodGet, "/missing", nil, nil)
+	if err == nil || missing != (Zone{}) {
+		t.Fatalf("expected error and zero value, got %#v (err %v)", missing, err)
+	}
+}
+
+func TestBuildURL_PreservesEscapedSegments(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token", WithBaseURL("https://api.example.com/client/v4/"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	got, err := client.buildURL("/accounts/acc-1/access/apps/app%2F1", url.Values{"page": {"2"}})
+	if err != nil {
+		t.Fatalf("build URL: %v", err)
+	}
+	if want := "https://api.example.com/client/v4/accounts/acc-1/access/apps/app%2F1?page=2"; got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestBuildURL_MergesEndpointQuery(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token", WithBaseURL("https://api.example.com/client/v4"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		params   url.Values
+		want     string
+	}{
+		{
+			name:     "merges extra params",
+			endpoint: "/zones?account.id=acc-1&status=pending",
+			params:   url.Values{"page": {"2"}, "status": {"active"}},
+			want:     "https://api.example.com/client/v4/zones?account.id=acc-1&page=2&status=active",
+		},
+		{
+			name:     "keeps embedded query without params",
+			endpoint: "/zones?account.id=acc-1",
+			want:     "https://api.example.com/client/v4/zones?account.id=acc-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := client.buildURL(tt.endpoint, tt.params)
+			if err != nil {
+				t.Fatalf("build URL: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDo_MaxResponseHeaderBytes(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-Flood", strings.Repeat("a", 8<<10))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithRetries(0, time.Millisecond, time.Millisecond),
+		WithMaxResponseHeaderBytes(1<<10),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "header") {
+		t.Fatalf("expected response header size error, got %v", err)
+	}
+}
+
+func TestCustomJSONCodec(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"custom":true}` {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":{"id":"zone-1","name":"example.com"}}`))
+	}))
+	defer server.Close()
+
+	var marshals, unmarshals atomic.Int32
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithJSONMarshaler(func(any) ([]byte, error) {
+			marshals.Add(1)
+			return []byte(`{"custom":true}`), nil
+		}),
+		WithJSONUnmarshaler(func(data []byte, v any) error {
+			unmarshals.Add(1)
+			return json.Unmarshal(data, v)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var zone Zone
+	if err := client.DoWithOptions(context.Background(), http.MethodPost, "/zones", nil, map[string]any{"name": "x"}, &zone); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if zone.Name != "example.com" {
+		t.Fatalf("unexpected zone: %#v", zone)
+	}
+	if marshals.Load() != 1 {
+		t.Fatalf("expected custom marshaler to be used once, got %d", marshals.Load())
+	}
+	// Once for the envelope and once for the result.
+	if unmarshals.Load() != 2 {
+		t.Fatalf("expected custom unmarshaler to be used twice, got %d", unmarshals.Load())
+	}
+}
+
+func TestGlobalAPIKeyAuthentication(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Fatalf("unexpected Authorization header: %q", r.Header.Get("Authorization"))
+		}
+		if r.Header.Get("X-Auth-Email") != "ops@acme.com" || r.Header.Get("X-Auth-Key") != "global-key" {
+			t.Fatalf("unexpected auth headers: %v", r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":{"id":"zone-1","name":"example.com"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithGlobalAPIKey("ops@acme.com", "global-key"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if strings.Contains(fmt.Sprintf("%+v", client.Config()), "global-key") {
+		t.Fatalf("config must not expose the global API key")
+	}
+	if _, err := DoTyped[Zone](context.Background(), client, http.MethodGet, "/zones/zone-1", nil, nil); err != nil {
+		t.Fatalf("do typed: %v", err)
+	}
+}
+
+func TestNewCredentialValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		token string
+		opts  []Option
+	}{
+		{name: "no credentials"},
+		{name: "token and global key", token: "token", opts: []Option{WithGlobalAPIKey("ops@acme.com", "key")}},
+		{name: "global key without email", opts: []Option{WithGlobalAPIKey("", "key")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := New(tt.token, tt.opts...); err == nil {
+				t.Fatalf("expected credential validation error")
+			}
+		})
+	}
+}
+
+func TestNewFromEnvGlobalAPIKeyFallback(t *testing.T) {
+	t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	t.Setenv("CLOUDFLARE_EMAIL", "ops@acme.com")
+	t.Setenv("CLOUDFLARE_API_KEY", "global-key")
+
+	client, err := NewFromEnv()
+	if err != nil {
+		t.Fatalf("new from env: %v", err)
+	}
+	if client.email != "ops@acme.com" || client.apiKey != "global-key" || client.token != "" {
+		t.Fatalf("expected global API key credentials from env")
+	}
+
+	t.Setenv("CLOUDFLARE_API_KEY", "")
+	if _, err := NewFromEnv(); err == nil {
+		t.Fatalf("expected missing credentials error")
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		opts   []Option
+		wantUA string
+	}{
+		{name: "default", wantUA: "platform-core-go/"},
+		{name: "custom", opts: []Option{WithUserAgent("deployer/1.2")}, wantUA: "deployer/1.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.Store(r.Header.Get("User-Agent"))
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+			}))
+			defer server.Close()
+
+			opts := append([]Option{WithBaseURL(server.URL), WithTimeout(5 * time.Second)}, tt.opts...)
+			client, err := New("token", opts...)
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			if err := client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil); err != nil {
+				t.Fatalf("do: %v", err)
+			}
+			if ua, _ := got.Load().(string); !strings.HasPrefix(ua, tt.wantUA) {
+				t.Fatalf("expected User-Agent %q, got %q", tt.wantUA, ua)
+			}
+		})
+	}
+}
+
+func TestAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Cf-Api-Version"); got != "2026-03-01" {
+			t.Fatalf("unexpected API version header: %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithAPIVersion("2026-03-01"))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if err := client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+
+	for _, invalid := range []string{"2026-3-1", "03/01/2026", "2026-02-30", "latest"} {
+		if _, err := New("token", WithAPIVersion(invalid)); err == nil {
+			t.Fatalf("expected API version validation error for %q", invalid)
+		}
+	}
+}
+
+func TestClientStats(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[]}`))
+		case r.URL.Path == "/unsuccessful":
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":1000,"message":"boom"}]}`))
+		case calls.Add(1) == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(2, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if err := client.Do(ctx, http.MethodGet, "/missing", nil, nil, nil); err == nil {
+		t.Fatalf("expected not found error")
+	}
+
+	// A 2xx response reporting success false is an APIError, not a failure.
+	var apiErr *APIError
+	if err := client.Do(ctx, http.MethodGet, "/unsuccessful", nil, nil, nil); !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+
+	want := ClientStats{Requests: 3, Retries: 1, Failures: 1, Status2xx: 2, Status4xx: 1, Status5xx: 1}
+	if got := client.Stats(); got != want {
+		t.Fatalf("unexpected stats: %+v", got)
+	}
+	client.ResetStats()
+	if got := client.Stats(); got != (ClientStats{}) {
+		t.Fatalf("expected zero stats after reset, got %+v", got)
+	}
+}
+
+func TestUnsuccessfulEnvelopeReturnsAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":10000,"message":"Authentication error"},{"code":1003,"message":"Invalid"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %T: %v", err, err)
+	}
+	if codes := apiErr.Codes(); len(codes) != 2 || codes[0] != 10000 || codes[1] != 1003 {
+		t.Fatalf("unexpected codes: %v", codes)
+	}
+	want := "cloudflare API returned unsuccessful response: 10000:Authentication error, 1003:Invalid"
+	if err.Error() != want {
+		t.Fatalf("unexpected error string: %q", err.Error())
+	}
+}
+
+func TestRateLimitAwarenessDelaysNextRequest(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var starts []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		starts = append(starts, time.Now())
+		first := len(starts) == 1
+		mu.Unlock()
+
+		if first {
+			w.Header().Set("X-RateLimit-Remaining", "0")
+			w.Header().Set("X-RateLimit-Reset", "30")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+	}))
+	defer server.Close()
+
+	// The retry max delay caps the wait, keeping the test fast.
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithRetries(0, time.Millisecond, 100*time.Millisecond),
+		WithRateLimitAwareness(),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	for range 2 {
+		if err := client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil); err != nil {
+			t.Fatalf("do: %v", err)
+		}
+	}
+	if gap := starts[1].Sub(starts[0]); gap < 80*time.Millisecond {
+		t.Fatalf("expected second request to wait for the rate limit reset, gap %v", gap)
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{value: "12", want: 12 * time.Second, wantOK: true},
+		{value: "1700000005", want: 5 * time.Second, wantOK: true},
+		{value: "", wantOK: false},
+		{value: "-1", wantOK: false},
+		{value: "soon", wantOK: false},
+	}
+	for _, tt := range tests {
+		got, ok := parseRateLimitReset(tt.value, now)
+		if ok != tt.wantOK || got != tt.want {
+			t.Fatalf("parseRateLimitReset(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestMaxConcurrentRetriesFailsFastWhenSaturated(t *testing.T) {
+	t.Parallel()
+
+	hits := make(chan struct{}, 16)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits <- struct{}{}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithRetries(1, 300*time.Millisecond, 300*time.Millisecond),
+		WithMaxConcurrentRetries(1),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil)
+	}()
+	<-hits // The first request is now backing off and holds the only slot.
+
+	start := time.Now()
+	err = client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil)
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 status error, got %v", err)
+	}
+	if len(statusErr.RetryReasons) != 0 || time.Since(start) > 200*time.Millisecond {
+		t.Fatalf("expected saturated request to fail without retrying, took %v with reasons %v", time.Since(start), statusErr.RetryReasons)
+	}
+
+	if err := <-done; !errors.As(err, &statusErr) || len(statusErr.RetryReasons) != 1 {
+		t.Fatalf("expected first request to retry once, got %v", err)
+	}
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		method  string
+		reqOpts []RequestOption
+		wantKey func(keys []string) bool
+	}{
+		{
+			name:    "explicit key is constant across attempts",
+			method:  http.MethodPost,
+			reqOpts: []RequestOption{WithRetryUnsafeMethods(), WithIdempotencyKey("create-zone-1")},
+			wantKey: func(keys []string) bool { return keys[0] == "create-zone-1" && keys[1] == "create-zone-1" },
+		},
+		{
+			name:    "generated key is constant across attempts",
+			method:  http.MethodPost,
+			reqOpts: []RequestOption{WithRetryUnsafeMethods()},
+			wantKey: func(keys []string) bool { return len(keys[0]) == 36 && keys[0] == keys[1] },
+		},
+		{
+			name:    "key supplied with WithHeader is kept",
+			method:  http.MethodPost,
+			reqOpts: []RequestOption{WithRetryUnsafeMethods(), WithHeader("Idempotency-Key", "caller-key")},
+			wantKey: func(keys []string) bool { return keys[0] == "caller-key" && keys[1] == "caller-key" },
+		},
+		{
+			name:    "safe methods get no generated key",
+			method:  http.MethodPut,
+			reqOpts: []RequestOption{WithRetryUnsafeMethods()},
+			wantKey: func(keys []string) bool { return keys[0] == "" && keys[1] == "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			var keys []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				keys = append(keys, r.Header.Get("Idempotency-Key"))
+				attempt := len(keys)
+				mu.Unlock()
+
+				if attempt == 1 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+			}))
+			defer server.Close()
+
+			client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(1, time.Millisecond, time.Millisecond))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			err = client.DoWithOptions(context.Background(), tt.method, "/zones", nil, map[string]string{"name": "acme.com"}, nil, tt.reqOpts...)
+			if err != nil {
+				t.Fatalf("do: %v", err)
+			}
+			if len(keys) != 2 || !tt.wantKey(keys) {
+				t.Fatalf("unexpected idempotency keys: %q", keys)
+			}
+		})
+	}
+}
+
+func TestRequestIdentityHeadersAreSticky(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var seen []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		seen = append(seen, r.Header.Clone())
+		attempt := len(seen)
+		mu.Unlock()
+
+		if attempt < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(2, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.DoWithOptions(
+		context.Background(),
+		http.MethodPost,
+		"/zones",
+		nil,
+		map[string]string{"name": "acme.com"},
+		nil,
+		WithRetryUnsafeMethods(),
+		WithRequestID("deploy-42"),
+		WithHeader("X-Trace", "t-1"),
+	)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(seen))
+	}
+	for i, header := range seen {
+		if header.Get("X-Request-Id") != "deploy-42" || header.Get("X-Trace") != "t-1" {
+			t.Fatalf("attempt %d: unexpected identity headers: %v", i+1, header)
+		}
+		if key := header.Get("Idempotency-Key"); key == "" || key != seen[0].Get("Idempotency-Key") {
+			t.Fatalf("attempt %d: idempotency key changed: %q", i+1, key)
+		}
+	}
+}
+
+func TestResponseInspector(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("CF-Ray", fmt.Sprintf("ray-%d", calls.Add(1)))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":1003,"message":"Invalid"}]}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var rays []string
+	var bodies []string
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithResponseInspector(func(resp *http.Response, body []byte) {
+			mu.Lock()
+			rays = append(rays, resp.Header.Get("CF-Ray"))
+			bodies = append(bodies, string(body))
+			mu.Unlock()
+			panic("inspector bug")
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status error despite inspector panic, got %v", err)
+	}
+	if len(rays) != 1 || rays[0] != "ray-1" || !strings.Contains(bodies[0], "Invalid") {
+		t.Fatalf("unexpected inspected responses: %v %v", rays, bodies)
+	}
+}
+
+func TestWithLoggerRecordsAttemptsAndRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[]}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := New(
+		"secret-token",
+		WithBaseURL(server.URL),
+		WithRetries(1, time.Millisecond, time.Millisecond),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	params := url.Values{"name": []string{"example.com"}}
+	if err := client.Do(context.Background(), http.MethodGet, "/zones", params, nil, nil); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "secret-token") || strings.Contains(out, "example.com") {
+		t.Fatalf("log leaked token or query: %s", out)
+	}
+	for _, want := range []string{"status=503", "status=200", "attempt=2", "retry scheduled", "reason=status:503", "path=/zones"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in log output: %s", want, out)
+		}
+	}
+}
+
+func TestRetryDelayCapsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token", WithRetries(1, time.Millisecond, 30*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	farFuture := time.Now().Add(24 * time.Hour).UTC().Format(http.TimeFormat)
+	for _, header := range []string{"600", farFuture} {
+		if got := client.retryDelay(0, header); got != 30*time.Second {
+			t.Fatalf("Retry-After %q: expected delay capped at 30s, got %s", header, got)
+		}
+	}
+	if got := client.retryDelay(0, "5"); got != 5*time.Second {
+		t.Fatalf("expected 5s delay under the cap, got %s", got)
+	}
+}
+
+func TestOperationBudgetSpansRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithRetries(10, 40*time.Millisecond, 40*time.Millisecond),
+		WithOperationBudget(100*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	started := time.Now()
+	err = client.Do(context.Background(), http.MethodGet, "/zones", nil, nil, nil)
+	if !errors.Is(err, ErrOperationTimeout) {
+		t.Fatalf("expected ErrOperationTimeout, got %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Fatalf("expected the budget to stop retries early, took %s", elapsed)
+	}
+	if got := calls.Load(); got < 2 || got > 5 {
+		t.Fatalf("expected a few attempts within the budget, got %d", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.Do(ctx, http.MethodGet, "/zones", nil, nil, nil); errors.Is(err, ErrOperationTimeout) {
+		t.Fatalf("caller cancellation must not be reported as ErrOperationTimeout: %v", err)
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	t.Parallel()
+
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.DoWithOptions(
+		context.Background(),
+		http.MethodGet,
+		"/zones",
+		nil,
+		nil,
+		nil,
+		WithHeader("CF-Access-Client-Id", "client.access"),
+		WithHeader("X-Feature", "a"),
+		WithHeader("X-Feature", "b"),
+		WithHeader("User-Agent", "deploy-bot/1.0"),
+	)
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	if got.Get("Cf-Access-Client-Id") != "client.access" || got.Get("User-Agent") != "deploy-bot/1.0" {
+		t.Fatalf("unexpected headers: %v", got)
+	}
+	if values := got.Values("X-Feature"); len(values) != 2 || values[0] != "a" || values[1] != "b" {
+		t.Fatalf("expected accumulated X-Feature values, got %v", values)
+	}
+	if got.Get("Authorization") != "Bearer token" {
+		t.Fatalf("unexpected Authorization header: %q", got.Get("Authorization"))
+	}
+
+	for _, key := range []string{"authorization", "Content-Type", "X-Auth-Key"} {
+		err := client.DoWithOptions(context.Background(), http.MethodGet, "/zones", nil, nil, nil, WithHeader(key, "x"))
+		if err == nil || !strings.Contains(err.Error(), "reserved") {
+			t.Fatalf("expected reserved header error for %s, got %v", key, err)
+		}
+	}
+}
+
+func TestHTTPStatusErrorMatchesAuthSentinels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status           int
+		wantUnauthorized bool
+		wantForbidden    bool
+	}{
+		{status: http.StatusUnauthorized, wantUnauthorized: true},
+		{status: http.StatusForbidden, wantForbidden: true},
+		{status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("list zones: %w", &HTTPStatusError{StatusCode: tt.status})
+		if got := errors.Is(err, autherr.ErrUnauthorized); got != tt.wantUnauthorized {
+			t.Fatalf("status %d: errors.Is(autherr.ErrUnauthorized) = %v", tt.status, got)
+		}
+		if got := errors.Is(err, autherr.ErrForbidden); got != tt.wantForbidden {
+			t.Fatalf("status %d: errors.Is(autherr.ErrForbidden) = %v", tt.status, got)
+		}
+		if got := errors.Is(err, ErrUnauthorized); got != (tt.wantUnauthorized || tt.wantForbidden) {
+			t.Fatalf("status %d: errors.Is(ErrUnauthorized) = %v", tt.status, got)
+		}
+	}
+}
+
+func TestWithHTTPClientLeavesCallerClientUnchanged(t *testing.T) {
+	t.Parallel()
+
+	transport := &http.Transport{}
+	shared := &http.Client{Transport: transport}
+	for range 2 {
+		_, err := New("token",
+			WithHTTPClient(shared),
+			WithTimeout(5*time.Second),
+			WithResponseHeaderTimeout(time.Second),
+			WithMaxResponseHeaderBytes(4096),
+			WithTraceContextPropagation(),
+		)
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+	}
+
+	if shared.Transport != transport || shared.Timeout != 0 || shared.CheckRedirect != nil {
+		t.Fatalf("caller's client was modified: %#v", shared)
+	}
+	if transport.ResponseHeaderTimeout != 0 || transport.MaxResponseHeaderBytes != 0 {
+		t.Fatalf("caller's transport was modified: %#v", transport)
+	}
+}
+
+func TestCustomJSONCodecCoversTypedDecodersAndCurl(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":{"hold":true,"include_subdomains":"true"}}`))
+	}))
+	defer server.Close()
+
+	var mu sync.Mutex
+	var decoded []string
+	var marshals atomic.Int32
+	var logged bytes.Buffer
+	client, err := New(
+		"token",
+		WithBaseURL(server.URL),
+		WithTimeout(5*time.Second),
+		WithCurlLogging(&logged),
+		WithJSONMarshaler(func(v any) ([]byte, error) {
+			marshals.Add(1)
+			return json.Marshal(v)
+		}),
+		WithJSONUnmarshaler(func(data []byte, v any) error {
+			mu.Lock()
+			decoded = append(decoded, string(data))
+			mu.Unlock()
+			return json.Unmarshal(data, v)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	hold, err := client.GetZoneHold(context.Background(), "zone-1")
+	if err != nil {
+		t.Fatalf("get zone hold: %v", err)
+	}
+	if !hold.IncludeSubdomains {
+		t.Fatalf("unexpected hold: %#v", hold)
+	}
+	if !slices.Contains(decoded, `"true"`) {
+		t.Fatalf("expected include_subdomains to be decoded by the custom unmarshaler, saw %q", decoded)
+	}
+
+	if err := client.Do(context.Background(), http.MethodPost, "/zones", nil, map[string]any{"secret": "s"}, nil); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+	// Once for the request body and once for the redacted curl body.
+	if marshals.Load() != 2 {
+		t.Fatalf("expected custom marshaler to be used twice, got %d", marshals.Load())
+	}
+	if strings.Contains(logged.String(), `"s"`) {
+		t.Fatalf("curl log leaked secret: %s", logged.String())
+	}
+}
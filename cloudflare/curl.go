@@ -0,0 +1,117 @@
+package cloudflare
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const redactedValue = "***"
+
+// sensitiveHeaders are always redacted from curl output.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":           true,
+	"Cookie":                  true,
+	"X-Auth-Key":              true,
+	"X-Auth-Email":            true,
+	"Cf-Access-Client-Secret": true,
+}
+
+// sensitiveFieldMarkers redact JSON body fields whose lowercased name contains
+// any of them, such as private_key, api_key, psk, client_secret, and
+// certificate.
+var sensitiveFieldMarkers = []string{"secret", "password", "token", "key", "psk", "private", "credential", "cert"}
+
+// writeCurl writes a single-line curl equivalent of req to w. Credentials in
+// headers and in JSON body fields are replaced with ***.
+func (c *Client) writeCurl(w io.Writer, req *http.Request, payload []byte) {
+	var b strings.Builder
+	b.WriteString("curl -X ")
+	b.WriteString(req.Method)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		for _, value := range req.Header[name] {
+			if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+				value = redactedValue
+			}
+			b.WriteString(" -H ")
+			b.WriteString(shellQuote(name + ": " + value))
+		}
+	}
+
+	if len(payload) > 0 {
+		b.WriteString(" --data ")
+		b.WriteString(shellQuote(string(c.redactBody(payload))))
+	}
+
+	b.WriteString(" ")
+	b.WriteString(shellQuote(req.URL.String()))
+	b.WriteString("\n")
+	_, _ = io.WriteString(w, b.String())
+}
+
+// redactBody masks sensitive JSON fields using c's JSON codec. Bodies that are
+// not JSON are replaced entirely because their contents cannot be inspected.
+func (c *Client) redactBody(payload []byte) []byte {
+	var decoded any
+	if err := c.decodeForRedaction(payload, &decoded); err != nil {
+		return []byte(redactedValue)
+	}
+
+	redacted, err := c.marshalJSON(redactValue(decoded))
+	if err != nil {
+		return []byte(redactedValue)
+	}
+	return redacted
+}
+
+// decodeForRedaction decodes payload with the configured unmarshaler, or with
+// encoding/json keeping numbers exact so the logged body matches the request.
+func (c *Client) decodeForRedaction(payload []byte, v any) error {
+	if c.cfg.JSONUnmarshal != nil {
+		return c.cfg.JSONUnmarshal(payload, v)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func redactValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if isSensitiveField(key) {
+				typed[key] = redactedValue
+				continue
+			}
+			typed[key] = redactValue(nested)
+		}
+	case []any:
+		for i, nested := range typed {
+			typed[i] = redactValue(nested)
+		}
+	}
+	return value
+}
+
+func isSensitiveField(name string) bool {
+	lower := strings.ToLower(name)
+	for _, marker := range sensitiveFieldMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithCurlLoggingRedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"result":{}}`))
+	}))
+	defer server.Close()
+
+	var logged bytes.Buffer
+	client, err := New("token-123", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithCurlLogging(&logged))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	body := map[string]any{"name": "hook", "secret": "s3cr3t", "nested": map[string]any{"api_token": "abc"}}
+	if err := client.Do(context.Background(), http.MethodPost, "/accounts/acc/hooks", nil, body, nil); err != nil {
+		t.Fatalf("do: %v", err)
+	}
+
+	out := logged.String()
+	for _, leaked := range []string{"token-123", "s3cr3t", "abc"} {
+		if strings.Contains(out, leaked) {
+			t.Fatalf("curl output leaked %q: %s", leaked, out)
+		}
+	}
+	for _, want := range []string{
+		"curl -X POST",
+		"-H 'Authorization: ***'",
+		`"name":"hook"`,
+		"'" + server.URL + "/accounts/acc/hooks'",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("curl output missing %q: %s", want, out)
+		}
+	}
+}
+
+func TestShellQuoteEscapesSingleQuotes(t *testing.T) {
+	t.Parallel()
+
+	if got := shellQuote("it's"); got != `'it'\''s'` {
+		t.Fatalf("unexpected quoting: %s", got)
+	}
+}
+
+func TestRedactBodyMasksNonJSON(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if got := string(client.redactBody([]byte("password=hunter2"))); got != redactedValue {
+		t.Fatalf("expected non-JSON body to be redacted, got %q", got)
+	}
+}
+
+func TestRedactBodyMasksKeyMaterial(t *testing.T) {
+	t.Parallel()
+
+	body := `{"name":"tunnel","psk":"p1","key":"k1","private_key":"k2","api_key":"k3",` +
+		`"certificate":"c1","credentials":{"id":"c2"},"peers":[{"public_key":"k4","endpoint":"203.0.113.1"}]}`
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got := string(client.redactBody([]byte(body)))
+	for _, leaked := range []string{"p1", "k1", "k2", "k3", "k4", "c1", "c2"} {
+		if strings.Contains(got, `"`+leaked+`"`) {
+			t.Fatalf("redacted body leaked %q: %s", leaked, got)
+		}
+	}
+	for _, want := range []string{`"name":"tunnel"`, `"endpoint":"203.0.113.1"`} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("redacted body missing %q: %s", want, got)
+		}
+	}
+}
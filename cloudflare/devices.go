@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DeviceSettings are the account-wide WARP client settings. Nil fields are
+// left unchanged on update.
+type DeviceSettings struct {
+	GatewayProxyEnabled                *bool `json:"gateway_proxy_enabled,omitempty"`
+	GatewayUDPProxyEnabled             *bool `json:"gateway_udp_proxy_enabled,omitempty"`
+	RootCertificateInstallationEnabled *bool `json:"root_certificate_installation_enabled,omitempty"`
+	UseZTVirtualIP                     *bool `json:"use_zt_virtual_ip,omitempty"`
+	DisableForTime                     *int  `json:"disable_for_time,omitempty"`
+}
+
+// EnrollmentPolicy controls which users may enroll WARP devices. Include,
+// Exclude, and Require take rules built with NewAccessRules.
+type EnrollmentPolicy struct {
+	ID         string           `json:"id,omitempty"`
+	Name       string           `json:"name"`
+	Decision   string           `json:"decision"`
+	Precedence int              `json:"precedence,omitempty"`
+	Include    []map[string]any `json:"include"`
+	Exclude    []map[string]any `json:"exclude,omitempty"`
+	Require    []map[string]any `json:"require,omitempty"`
+}
+
+// DevicesService provides Zero Trust WARP device settings and enrollment operations.
+type DevicesService struct {
+	client *Client
+}
+
+// Devices returns the Devices service API.
+func (c *Client) Devices() *DevicesService {
+	return &DevicesService{client: c}
+}
+
+// GetDeviceSettings returns the account's WARP device settings.
+func (d *DevicesService) GetDeviceSettings(
+	ctx context.Context,
+	accountID string,
+	reqOpts ...RequestOption,
+) (*DeviceSettings, error) {
+	var settings DeviceSettings
+	if err := d.do(ctx, accountID, http.MethodGet, "/settings", nil, &settings, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateDeviceSettings updates the account's WARP device settings with a
+// PATCH, so settings left nil keep their current values.
+func (d *DevicesService) UpdateDeviceSettings(
+	ctx context.Context,
+	accountID string,
+	settings DeviceSettings,
+	reqOpts ...RequestOption,
+) (*DeviceSettings, error) {
+	var updated DeviceSettings
+	if err := d.do(ctx, accountID, http.MethodPatch, "/settings", settings, &updated, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// CreateEnrollmentPolicy creates a WARP device enrollment policy.
+func (d *DevicesService) CreateEnrollmentPolicy(
+	ctx context.Context,
+	accountID string,
+	policy EnrollmentPolicy,
+	reqOpts ...RequestOption,
+) (*EnrollmentPolicy, error) {
+	if strings.TrimSpace(policy.Name) == "" {
+		return nil, errors.New("enrollment policy name must not be empty")
+	}
+	if len(policy.Include) == 0 {
+		return nil, errors.New("enrollment policy must include at least one rule")
+	}
+
+	var created EnrollmentPolicy
+	if err := d.do(ctx, accountID, http.MethodPost, "/enrollment_policies", policy, &created, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// ListEnrollmentPolicies lists WARP device enrollment policies.
+func (d *DevicesService) ListEnrollmentPolicies(
+	ctx context.Context,
+	accountID string,
+	reqOpts ...RequestOption,
+) ([]EnrollmentPolicy, error) {
+	var policies []EnrollmentPolicy
+	if err := d.do(ctx, accountID, http.MethodGet, "/enrollment_policies", nil, &policies, reqOpts...); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (d *DevicesService) do(
+	ctx context.Context,
+	accountID string,
+	method string,
+	endpoint string,
+	requestBody any,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return err
+	}
+
+	return d.client.DoWithOptions(
+		ctx,
+		method,
+		fmt.Sprintf("/%s/devices%s", prefix, endpoint),
+		nil,
+		requestBody,
+		out,
+		reqOpts...,
+	)
+}
@@ -0,0 +1,106 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDevicesSettings(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/acc-1/devices/settings" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		settings := map[string]any{"gateway_proxy_enabled": true}
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPatch:
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if len(payload) != 1 || payload["gateway_udp_proxy_enabled"] != false {
+				t.Fatalf("expected only the set field in the payload, got %#v", payload)
+			}
+			settings["gateway_udp_proxy_enabled"] = false
+		default:
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": settings})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	settings, err := client.Devices().GetDeviceSettings(context.Background(), "acc-1")
+	if err != nil {
+		t.Fatalf("get device settings: %v", err)
+	}
+	if settings.GatewayProxyEnabled == nil || !*settings.GatewayProxyEnabled {
+		t.Fatalf("unexpected settings: %#v", settings)
+	}
+
+	disabled := false
+	updated, err := client.Devices().UpdateDeviceSettings(context.Background(), "acc-1", DeviceSettings{GatewayUDPProxyEnabled: &disabled})
+	if err != nil {
+		t.Fatalf("update device settings: %v", err)
+	}
+	if updated.GatewayUDPProxyEnabled == nil || *updated.GatewayUDPProxyEnabled {
+		t.Fatalf("unexpected updated settings: %#v", updated)
+	}
+}
+
+func TestDevicesCreateEnrollmentPolicy(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/accounts/acc-1/devices/enrollment_policies" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload EnrollmentPolicy
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if len(payload.Include) != 1 || payload.Include[0]["email_domain"] == nil || len(payload.Require) != 1 {
+			t.Fatalf("unexpected policy rules: %#v", payload)
+		}
+
+		payload.ID = "pol-1"
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": payload})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	policy, err := client.Devices().CreateEnrollmentPolicy(context.Background(), "acc-1", EnrollmentPolicy{
+		Name:     "employees",
+		Decision: "allow",
+		Include:  NewAccessRules().EmailDomain("acme.com").Build(),
+		Require:  NewAccessRules().Country("us").Build(),
+	})
+	if err != nil {
+		t.Fatalf("create enrollment policy: %v", err)
+	}
+	if policy.ID != "pol-1" {
+		t.Fatalf("unexpected policy: %#v", policy)
+	}
+
+	if _, err := client.Devices().CreateEnrollmentPolicy(context.Background(), "acc-1", EnrollmentPolicy{Name: "empty"}); err == nil {
+		t.Fatalf("expected missing include rule validation error")
+	}
+}
@@ -0,0 +1,137 @@
+package diff
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// DefaultIgnore lists the server-managed fields Diff skips unless WithIgnore
+// replaces the list.
+var DefaultIgnore = []string{"id", "created_on", "modified_on"}
+
+// FieldChange is a field whose value differs between the two states. Path uses
+// dots for object keys and brackets for array indexes, such as
+// "include[0].email". Old holds the actual value and New the desired one; a
+// nil side means the field is absent or null there.
+type FieldChange struct {
+	Path string
+	Old  any
+	New  any
+}
+
+// Option customizes Diff.
+type Option func(*config)
+
+type config struct {
+	ignore []string
+}
+
+// WithIgnore replaces DefaultIgnore. Each entry matches either a field name at
+// any depth or a full path such as "meta.auto_added".
+func WithIgnore(fields ...string) Option {
+	return func(cfg *config) {
+		cfg.ignore = fields
+	}
+}
+
+// Diff compares desired and actual after encoding both as JSON and reports the
+// changed fields sorted by path. Objects are compared key by key and arrays
+// index by index, so values must be JSON-marshalable.
+func Diff(desired any, actual any, opts ...Option) ([]FieldChange, error) {
+	cfg := config{ignore: DefaultIgnore}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+
+	desiredValue, err := normalize(desired)
+	if err != nil {
+		return nil, fmt.Errorf("encode desired state: %w", err)
+	}
+	actualValue, err := normalize(actual)
+	if err != nil {
+		return nil, fmt.Errorf("encode actual state: %w", err)
+	}
+
+	changes := []FieldChange{}
+	cfg.compare("", actualValue, desiredValue, &changes)
+	slices.SortFunc(changes, func(a, b FieldChange) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	return changes, nil
+}
+
+// normalize round-trips v through JSON so both states use the same types.
+func normalize(v any) (any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (cfg config) compare(path string, oldValue any, newValue any, changes *[]FieldChange) {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+	if oldIsObject && newIsObject {
+		for key := range keys(oldObject, newObject) {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			if cfg.ignored(key, childPath) {
+				continue
+			}
+			cfg.compare(childPath, oldObject[key], newObject[key], changes)
+		}
+		return
+	}
+
+	oldArray, oldIsArray := oldValue.([]any)
+	newArray, newIsArray := newValue.([]any)
+	if oldIsArray && newIsArray {
+		for i := range max(len(oldArray), len(newArray)) {
+			var oldItem, newItem any
+			if i < len(oldArray) {
+				oldItem = oldArray[i]
+			}
+			if i < len(newArray) {
+				newItem = newArray[i]
+			}
+			itemPath := path + "[" + strconv.Itoa(i) + "]"
+			if slices.Contains(cfg.ignore, itemPath) {
+				continue
+			}
+			cfg.compare(itemPath, oldItem, newItem, changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(oldValue, newValue) {
+		*changes = append(*changes, FieldChange{Path: path, Old: oldValue, New: newValue})
+	}
+}
+
+func (cfg config) ignored(key string, path string) bool {
+	return slices.Contains(cfg.ignore, key) || slices.Contains(cfg.ignore, path)
+}
+
+func keys(a map[string]any, b map[string]any) map[string]struct{} {
+	all := make(map[string]struct{}, len(a)+len(b))
+	for key := range a {
+		all[key] = struct{}{}
+	}
+	for key := range b {
+		all[key] = struct{}{}
+	}
+	return all
+}
@@ -0,0 +1,97 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+type record struct {
+	ID        string            `json:"id,omitempty"`
+	Name      string            `json:"name"`
+	Proxied   bool              `json:"proxied"`
+	TTL       int               `json:"ttl"`
+	Tags      []string          `json:"tags,omitempty"`
+	CreatedOn string            `json:"created_on,omitempty"`
+	Meta      map[string]string `json:"meta,omitempty"`
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	desired := record{Name: "www", Proxied: true, TTL: 1, Tags: []string{"web", "prod"}}
+	actual := record{
+		ID:        "rec-1",
+		Name:      "www",
+		TTL:       300,
+		Tags:      []string{"web"},
+		CreatedOn: "2026-01-01T00:00:00Z",
+		Meta:      map[string]string{"auto_added": "false", "source": "api"},
+	}
+
+	tests := []struct {
+		name string
+		opts []Option
+		want []FieldChange
+	}{
+		{
+			name: "ignores server-managed fields by default",
+			want: []FieldChange{
+				{Path: "meta", Old: map[string]any{"auto_added": "false", "source": "api"}},
+				{Path: "proxied", Old: false, New: true},
+				{Path: "tags[1]", New: "prod"},
+				{Path: "ttl", Old: float64(300), New: float64(1)},
+			},
+		},
+		{
+			name: "custom ignore list matches names and paths",
+			opts: []Option{WithIgnore("ttl", "meta", "tags[1]")},
+			want: []FieldChange{
+				{Path: "created_on", Old: "2026-01-01T00:00:00Z"},
+				{Path: "id", Old: "rec-1"},
+				{Path: "proxied", Old: false, New: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(desired, actual, tt.opts...)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("unexpected changes:\n got %#v\nwant %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffNestedAndEqual(t *testing.T) {
+	t.Parallel()
+
+	desired := map[string]any{"include": []map[string]any{{"email": map[string]string{"email": "a@acme.com"}}}}
+	actual := map[string]any{"include": []map[string]any{{"email": map[string]string{"email": "b@acme.com"}}}}
+
+	got, err := Diff(desired, actual)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	want := []FieldChange{{Path: "include[0].email.email", Old: "b@acme.com", New: "a@acme.com"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected changes: %#v", got)
+	}
+
+	got, err = Diff(desired, desired)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	if len(got) != 0 {
+		t.Fatalf("expected no changes for equal states, got %#v", got)
+	}
+
+	if _, err := Diff(map[string]any{"bad": make(chan int)}, actual); err == nil {
+		t.Fatalf("expected error for a value that cannot be marshaled")
+	}
+}
@@ -0,0 +1,3 @@
+// Package diff compares desired and actual Cloudflare resource states field by
+// field, for drift detection and plan output before applying changes.
+package diff
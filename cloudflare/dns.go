@@ -0,0 +1,283 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRecordPollInterval = 2 * time.Second
+	// automaticTTL is the TTL value Cloudflare uses for "automatic"; proxied
+	// records must use it.
+	automaticTTL = 1
+)
+
+// ErrAmbiguousRecord indicates more than one DNS record matches a type and name
+// where exactly one was expected.
+var ErrAmbiguousRecord = errors.New("multiple DNS records match")
+
+// DNSRecord represents a Cloudflare DNS record.
+type DNSRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl,omitempty"`
+	Proxied *bool  `json:"proxied,omitempty"`
+}
+
+// DNSService provides Cloudflare DNS record operations.
+type DNSService struct {
+	client *Client
+}
+
+// DNS returns the DNS service API.
+func (c *Client) DNS() *DNSService {
+	return &DNSService{client: c}
+}
+
+// ListRecords lists every DNS record in a zone. params filters the list, for
+// example by type or name.
+func (d *DNSService) ListRecords(
+	ctx context.Context,
+	zoneID string,
+	params url.Values,
+	reqOpts ...RequestOption,
+) ([]DNSRecord, error) {
+	endpoint, err := dnsRecordsPath(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	return listAllPages[DNSRecord](ctx, d.client, endpoint, params, "DNS record list", reqOpts...)
+}
+
+// CreateRecord creates a DNS record. Pass WithRetryUnsafeMethods to allow
+// retries, which may create duplicates if an earlier attempt succeeded.
+func (d *DNSService) CreateRecord(
+	ctx context.Context,
+	zoneID string,
+	record DNSRecord,
+	reqOpts ...RequestOption,
+) (*DNSRecord, error) {
+	endpoint, err := dnsRecordsPath(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeRecordTTL(&record); err != nil {
+		return nil, err
+	}
+
+	var created DNSRecord
+	if err := d.client.DoWithOptions(ctx, http.MethodPost, endpoint, nil, record, &created, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateRecord replaces an existing DNS record.
+func (d *DNSService) UpdateRecord(
+	ctx context.Context,
+	zoneID string,
+	recordID string,
+	record DNSRecord,
+	reqOpts ...RequestOption,
+) (*DNSRecord, error) {
+	endpoint, err := dnsRecordPath(ctx, zoneID, recordID)
+	if err != nil {
+		return nil, err
+	}
+	if err := normalizeRecordTTL(&record); err != nil {
+		return nil, err
+	}
+
+	var updated DNSRecord
+	if err := d.client.DoWithOptions(ctx, http.MethodPut, endpoint, nil, record, &updated, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteRecord deletes a DNS record.
+func (d *DNSService) DeleteRecord(
+	ctx context.Context,
+	zoneID string,
+	recordID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := dnsRecordPath(ctx, zoneID, recordID)
+	if err != nil {
+		return err
+	}
+	return d.client.DoWithOptions(ctx, http.MethodDelete, endpoint, nil, nil, nil, reqOpts...)
+}
+
+// UpsertRecord makes the record with record's type and name match record,
+// creating or updating it as needed. It returns the resulting record and
+// whether a change was made. A zero TTL or nil Proxied leaves the existing
+// value alone: it is ignored when comparing and carried over on update. More
+// than one matching record is reported as ErrAmbiguousRecord. reqOpts apply
+// only to the create or update, not to the lookup.
+func (d *DNSService) UpsertRecord(
+	ctx context.Context,
+	zoneID string,
+	record DNSRecord,
+	reqOpts ...RequestOption,
+) (*DNSRecord, bool, error) {
+	if strings.TrimSpace(record.Type) == "" || strings.TrimSpace(record.Name) == "" {
+		return nil, false, errors.New("DNS record type and name must not be empty")
+	}
+	if err := normalizeRecordTTL(&record); err != nil {
+		return nil, false, err
+	}
+
+	params := url.Values{}
+	params.Set("type", record.Type)
+	params.Set("name", record.Name)
+	// reqOpts are for the write: an idempotency key or a page limit on the
+	// lookup could hide an existing record and cause a duplicate.
+	existing, err := d.ListRecords(ctx, zoneID, params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch len(existing) {
+	case 0:
+		created, err := d.CreateRecord(ctx, zoneID, record, reqOpts...)
+		if err != nil {
+			return nil, false, err
+		}
+		return created, true, nil
+	case 1:
+		current := existing[0]
+		if recordMatches(current, record) {
+			return &current, false, nil
+		}
+		// UpdateRecord replaces the record, so keep what the caller left unset.
+		if record.TTL == 0 {
+			record.TTL = current.TTL
+		}
+		if record.Proxied == nil {
+			record.Proxied = current.Proxied
+		}
+		updated, err := d.UpdateRecord(ctx, zoneID, current.ID, record, reqOpts...)
+		if err != nil {
+			return nil, false, err
+		}
+		return updated, true, nil
+	default:
+		return nil, false, fmt.Errorf("%w: %d %s records named %s", ErrAmbiguousRecord, len(existing), record.Type, record.Name)
+	}
+}
+
+// GetRecord returns a single DNS record.
+func (d *DNSService) GetRecord(
+	ctx context.Context,
+	zoneID string,
+	recordID string,
+	reqOpts ...RequestOption,
+) (*DNSRecord, error) {
+	endpoint, err := dnsRecordPath(ctx, zoneID, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	var record DNSRecord
+	if err := d.client.DoWithOptions(ctx, http.MethodGet, endpoint, nil, nil, &record, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// WaitForRecord polls GetRecord every poll interval until until reports true,
+// or until the record exists when until is nil. Not-found responses keep
+// polling; other errors and context expiry end the wait.
+func (d *DNSService) WaitForRecord(
+	ctx context.Context,
+	zoneID string,
+	recordID string,
+	poll time.Duration,
+	until func(*DNSRecord) bool,
+) (*DNSRecord, error) {
+	if poll <= 0 {
+		poll = defaultRecordPollInterval
+	}
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		record, err := d.GetRecord(ctx, zoneID, recordID)
+		var statusErr *HTTPStatusError
+		switch {
+		case err == nil:
+			if until == nil || until(record) {
+				return record, nil
+			}
+		case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+		default:
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for DNS record %s: %w", recordID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// recordMatches reports whether current already satisfies desired.
+func recordMatches(current DNSRecord, desired DNSRecord) bool {
+	if current.Content != desired.Content {
+		return false
+	}
+	if desired.TTL != 0 && current.TTL != desired.TTL {
+		return false
+	}
+	if desired.Proxied != nil && (current.Proxied == nil || *current.Proxied != *desired.Proxied) {
+		return false
+	}
+	return true
+}
+
+// normalizeRecordTTL defaults proxied records to the automatic TTL and rejects
+// any other TTL for them, which the API would otherwise refuse.
+func normalizeRecordTTL(record *DNSRecord) error {
+	if record.Proxied == nil || !*record.Proxied {
+		return nil
+	}
+	switch record.TTL {
+	case 0:
+		record.TTL = automaticTTL
+	case automaticTTL:
+	default:
+		return fmt.Errorf("proxied DNS record TTL must be %d (automatic), got %d", automaticTTL, record.TTL)
+	}
+	return nil
+}
+
+func dnsRecordsPath(ctx context.Context, zoneID string) (string, error) {
+	prefix, err := scopePrefix(ctx, ZoneScope(zoneID))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/%s/dns_records", prefix), nil
+}
+
+func dnsRecordPath(ctx context.Context, zoneID string, recordID string) (string, error) {
+	collection, err := dnsRecordsPath(ctx, zoneID)
+	if err != nil {
+		return "", err
+	}
+
+	cleanRecordID := strings.TrimSpace(recordID)
+	if cleanRecordID == "" {
+		return "", errors.New("DNS record ID must not be empty")
+	}
+	return fmt.Sprintf("%s/%s", collection, url.PathEscape(cleanRecordID)), nil
+}
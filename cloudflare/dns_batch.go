@@ -0,0 +1,118 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// DNSBatch groups DNS record changes applied atomically by BatchDNS. The API
+// applies deletes, then patches, then puts, then posts.
+type DNSBatch struct {
+	// Posts creates new records.
+	Posts []DNSRecord
+	// Patches partially updates existing records; only non-zero fields are
+	// sent. ID is required.
+	Patches []DNSRecord
+	// Puts replaces existing records. ID is required.
+	Puts []DNSRecord
+	// Deletes lists the IDs of records to delete.
+	Deletes []string
+}
+
+// DNSBatchResult holds the records returned for each operation kind, in the
+// order they were submitted.
+type DNSBatchResult struct {
+	Posts   []DNSRecord `json:"posts"`
+	Patches []DNSRecord `json:"patches"`
+	Puts    []DNSRecord `json:"puts"`
+	Deletes []DNSRecord `json:"deletes"`
+}
+
+type dnsBatchID struct {
+	ID string `json:"id"`
+}
+
+type dnsBatchPatch struct {
+	ID      string `json:"id"`
+	Type    string `json:"type,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Content string `json:"content,omitempty"`
+	TTL     int    `json:"ttl,omitempty"`
+	Proxied *bool  `json:"proxied,omitempty"`
+}
+
+type dnsBatchRequest struct {
+	Deletes []dnsBatchID    `json:"deletes,omitempty"`
+	Patches []dnsBatchPatch `json:"patches,omitempty"`
+	Puts    []DNSRecord     `json:"puts,omitempty"`
+	Posts   []DNSRecord     `json:"posts,omitempty"`
+}
+
+// BatchDNS applies a set of DNS record changes in one atomic request: either
+// every operation succeeds or none is applied. When the API rejects the batch
+// the returned *HTTPStatusError lists the failing operations in Errors.
+func (d *DNSService) BatchDNS(
+	ctx context.Context,
+	zoneID string,
+	batch DNSBatch,
+	reqOpts ...RequestOption,
+) (*DNSBatchResult, error) {
+	endpoint, err := dnsRecordsPath(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := newDNSBatchRequest(batch)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DNSBatchResult
+	if err := d.client.DoWithOptions(ctx, http.MethodPost, endpoint+"/batch", nil, request, &result, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func newDNSBatchRequest(batch DNSBatch) (dnsBatchRequest, error) {
+	var request dnsBatchRequest
+	if len(batch.Posts)+len(batch.Patches)+len(batch.Puts)+len(batch.Deletes) == 0 {
+		return request, errors.New("DNS batch must contain at least one operation")
+	}
+
+	for i, id := range batch.Deletes {
+		cleanID := strings.TrimSpace(id)
+		if cleanID == "" {
+			return request, fmt.Errorf("DNS batch delete %d: record ID must not be empty", i)
+		}
+		request.Deletes = append(request.Deletes, dnsBatchID{ID: cleanID})
+	}
+	for i, record := range batch.Patches {
+		if strings.TrimSpace(record.ID) == "" {
+			return request, fmt.Errorf("DNS batch patch %d: record ID must not be empty", i)
+		}
+		if err := normalizeRecordTTL(&record); err != nil {
+			return request, fmt.Errorf("DNS batch patch %d: %w", i, err)
+		}
+		request.Patches = append(request.Patches, dnsBatchPatch(record))
+	}
+	for i, record := range batch.Puts {
+		if strings.TrimSpace(record.ID) == "" {
+			return request, fmt.Errorf("DNS batch put %d: record ID must not be empty", i)
+		}
+		if err := normalizeRecordTTL(&record); err != nil {
+			return request, fmt.Errorf("DNS batch put %d: %w", i, err)
+		}
+		request.Puts = append(request.Puts, record)
+	}
+	for i, record := range batch.Posts {
+		if err := normalizeRecordTTL(&record); err != nil {
+			return request, fmt.Errorf("DNS batch post %d: %w", i, err)
+		}
+		request.Posts = append(request.Posts, record)
+	}
+	return request, nil
+}
@@ -0,0 +1,126 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDNSBatch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/zones/zone-1/dns_records/batch" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string][]map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if len(body["deletes"]) != 1 || body["deletes"][0]["id"] != "rec-old" {
+			t.Fatalf("unexpected deletes: %#v", body["deletes"])
+		}
+		patch := body["patches"][0]
+		if patch["id"] != "rec-1" || patch["content"] != "192.0.2.2" {
+			t.Fatalf("unexpected patch: %#v", patch)
+		}
+		if _, ok := patch["type"]; ok {
+			t.Fatalf("patch should omit unset fields: %#v", patch)
+		}
+		if body["posts"][0]["ttl"] != float64(1) {
+			t.Fatalf("expected proxied post to use automatic TTL: %#v", body["posts"][0])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": map[string]any{
+				"deletes": []map[string]any{{"id": "rec-old", "type": "A", "name": "old.acme.com"}},
+				"patches": []map[string]any{{"id": "rec-1", "type": "A", "name": "a.acme.com", "content": "192.0.2.2"}},
+				"posts":   []map[string]any{{"id": "rec-new", "type": "CNAME", "name": "www.acme.com", "content": "acme.com"}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	proxied := true
+	result, err := client.DNS().BatchDNS(context.Background(), "zone-1", DNSBatch{
+		Deletes: []string{"rec-old"},
+		Patches: []DNSRecord{{ID: "rec-1", Content: "192.0.2.2"}},
+		Posts:   []DNSRecord{{Type: "CNAME", Name: "www.acme.com", Content: "acme.com", Proxied: &proxied}},
+	})
+	if err != nil {
+		t.Fatalf("batch DNS: %v", err)
+	}
+	if len(result.Posts) != 1 || result.Posts[0].ID != "rec-new" || result.Patches[0].Content != "192.0.2.2" || result.Deletes[0].ID != "rec-old" {
+		t.Fatalf("unexpected batch result: %#v", result)
+	}
+}
+
+func TestDNSBatchSurfacesOperationErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": false,
+			"errors":  []map[string]any{{"code": 81058, "message": "puts[0]: An identical record already exists."}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = client.DNS().BatchDNS(context.Background(), "zone-1", DNSBatch{
+		Puts: []DNSRecord{{ID: "rec-1", Type: "A", Name: "a.acme.com", Content: "192.0.2.1"}},
+	})
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) {
+		t.Fatalf("expected HTTPStatusError, got %v", err)
+	}
+	if len(statusErr.Errors) != 1 || statusErr.Errors[0].Code != 81058 {
+		t.Fatalf("unexpected operation errors: %#v", statusErr.Errors)
+	}
+}
+
+func TestDNSBatchValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		batch DNSBatch
+	}{
+		{name: "empty batch", batch: DNSBatch{}},
+		{name: "delete without ID", batch: DNSBatch{Deletes: []string{" "}}},
+		{name: "patch without ID", batch: DNSBatch{Patches: []DNSRecord{{Content: "192.0.2.1"}}}},
+		{name: "put without ID", batch: DNSBatch{Puts: []DNSRecord{{Type: "A", Name: "a", Content: "192.0.2.1"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := client.DNS().BatchDNS(ctx, "zone-1", tt.batch); err == nil {
+				t.Fatalf("expected validation error")
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDNSWaitForRecordPollsUntilPredicate(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone-1/dns_records/rec-1" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": false, "errors": []map[string]any{{"code": 81044, "message": "Record not found"}}})
+		case 2:
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "rec-1", "content": "192.0.2.1"}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "rec-1", "content": "192.0.2.2"}})
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(0, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	record, err := client.DNS().WaitForRecord(context.Background(), "zone-1", "rec-1", time.Millisecond, func(r *DNSRecord) bool {
+		return r.Content == "192.0.2.2"
+	})
+	if err != nil {
+		t.Fatalf("wait for record: %v", err)
+	}
+	if record.Content != "192.0.2.2" || calls.Load() != 3 {
+		t.Fatalf("unexpected result after %d calls: %#v", calls.Load(), record)
+	}
+}
+
+func TestDNSWaitForRecordStopsOnContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"success":false}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(0, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = client.DNS().WaitForRecord(ctx, "zone-1", "rec-1", 5*time.Millisecond, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestDNSRecordCRUD(t *testing.T) {
+	t.Parallel()
+
+	var createAttempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/zones/zone-1/dns_records":
+			if r.URL.Query().Get("type") != "A" {
+				t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success":     true,
+				"result":      []map[string]any{{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.1"}},
+				"result_info": map[string]any{"page": 1, "total_pages": 1},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/zones/zone-1/dns_records":
+			if createAttempts.Add(1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				_, _ = w.Write([]byte(`{"success":false}`))
+				return
+			}
+			var record DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if record.TTL != 1 {
+				t.Fatalf("expected proxied record TTL to default to 1, got %d", record.TTL)
+			}
+			record.ID = "rec-2"
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": record})
+		case r.Method == http.MethodPut && r.URL.Path == "/zones/zone-1/dns_records/rec-2":
+			var record DNSRecord
+			if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			record.ID = "rec-2"
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": record})
+		case r.Method == http.MethodDelete && r.URL.Path == "/zones/zone-1/dns_records/rec-2":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "rec-2"}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithRetries(1, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	records, err := client.DNS().ListRecords(ctx, "zone-1", url.Values{"type": {"A"}})
+	if err != nil {
+		t.Fatalf("list records: %v", err)
+	}
+	if len(records) != 1 || records[0].Content != "192.0.2.1" {
+		t.Fatalf("unexpected records: %#v", records)
+	}
+
+	proxied := true
+	record := DNSRecord{Type: "A", Name: "api.example.com", Content: "192.0.2.2", Proxied: &proxied}
+	if _, err := client.DNS().CreateRecord(ctx, "zone-1", record); err == nil {
+		t.Fatalf("expected create without WithRetryUnsafeMethods to fail on 503")
+	}
+	created, err := client.DNS().CreateRecord(ctx, "zone-1", record, WithRetryUnsafeMethods())
+	if err != nil {
+		t.Fatalf("create record: %v", err)
+	}
+	if created.ID != "rec-2" {
+		t.Fatalf("unexpected created record: %#v", created)
+	}
+
+	created.Content = "192.0.2.3"
+	updated, err := client.DNS().UpdateRecord(ctx, "zone-1", created.ID, *created)
+	if err != nil {
+		t.Fatalf("update record: %v", err)
+	}
+	if updated.Content != "192.0.2.3" {
+		t.Fatalf("unexpected updated record: %#v", updated)
+	}
+
+	if err := client.DNS().DeleteRecord(ctx, "zone-1", "rec-2"); err != nil {
+		t.Fatalf("delete record: %v", err)
+	}
+}
+
+func TestDNSCreateRecordRejectsProxiedTTL(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	proxied := true
+	_, err = client.DNS().CreateRecord(context.Background(), "zone-1", DNSRecord{
+		Type:    "A",
+		Name:    "www.example.com",
+		Content: "192.0.2.1",
+		TTL:     300,
+		Proxied: &proxied,
+	})
+	if err == nil {
+		t.Fatalf("expected proxied TTL validation error")
+	}
+}
+
+func TestDNSUpsertRecord(t *testing.T) {
+	t.Parallel()
+
+	proxied := true
+	tests := []struct {
+		name        string
+		existing    []map[string]any
+		wantMethod  string
+		wantChanged bool
+		wantErr     error
+	}{
+		{name: "create when absent", wantMethod: http.MethodPost, wantChanged: true},
+		{
+			name:        "update when different",
+			existing:    []map[string]any{{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.9", "ttl": 1, "proxied": true}},
+			wantMethod:  http.MethodPut,
+			wantChanged: true,
+		},
+		{
+			name:     "no-op when identical",
+			existing: []map[string]any{{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.1", "ttl": 1, "proxied": true}},
+		},
+		{
+			name: "ambiguous",
+			existing: []map[string]any{
+				{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.1"},
+				{"id": "rec-2", "type": "A", "name": "www.example.com", "content": "192.0.2.2"},
+			},
+			wantErr: ErrAmbiguousRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var writeMethod string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					if r.URL.Query().Get("type") != "A" || r.URL.Query().Get("name") != "www.example.com" {
+						t.Fatalf("unexpected lookup query: %s", r.URL.RawQuery)
+					}
+					if r.Header.Get("X-Change") != "" {
+						t.Fatalf("write options reached the lookup")
+					}
+					existing := tt.existing
+					if existing == nil {
+						existing = []map[string]any{}
+					}
+					_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": existing})
+					return
+				}
+
+				writeMethod = r.Method
+				if r.Header.Get("X-Change") != "42" {
+					t.Fatalf("write options missing from %s", r.Method)
+				}
+				var record DNSRecord
+				if err := json.NewDecoder(r.Body).Decode(&record); err != nil {
+					t.Fatalf("decode request body: %v", err)
+				}
+				record.ID = "rec-1"
+				_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": record})
+			}))
+			defer server.Close()
+
+			client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			record, changed, err := client.DNS().UpsertRecord(context.Background(), "zone-1", DNSRecord{
+				Type:    "A",
+				Name:    "www.example.com",
+				Content: "192.0.2.1",
+				Proxied: &proxied,
+			}, WithHeader("X-Change", "42"))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("upsert record: %v", err)
+			}
+			if changed != tt.wantChanged || writeMethod != tt.wantMethod {
+				t.Fatalf("expected changed=%t via %q, got changed=%t via %q", tt.wantChanged, tt.wantMethod, changed, writeMethod)
+			}
+			if record.ID != "rec-1" || record.Content != "192.0.2.1" {
+				t.Fatalf("unexpected record: %#v", record)
+			}
+		})
+	}
+}
+
+func TestDNSUpsertRecordKeepsUnsetFields(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		existing    map[string]any
+		wantTTL     int
+		wantProxied bool
+	}{
+		{
+			name:        "proxied record",
+			existing:    map[string]any{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.9", "ttl": 1, "proxied": true},
+			wantTTL:     1,
+			wantProxied: true,
+		},
+		{
+			name:     "custom TTL",
+			existing: map[string]any{"id": "rec-1", "type": "A", "name": "www.example.com", "content": "192.0.2.9", "ttl": 300, "proxied": false},
+			wantTTL:  300,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sent DNSRecord
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": []map[string]any{tt.existing}})
+					return
+				}
+				if r.Method != http.MethodPut {
+					t.Fatalf("unexpected method: %s", r.Method)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+					t.Fatalf("decode request body: %v", err)
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": sent})
+			}))
+			defer server.Close()
+
+			client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			_, changed, err := client.DNS().UpsertRecord(context.Background(), "zone-1", DNSRecord{
+				Type:    "A",
+				Name:    "www.example.com",
+				Content: "192.0.2.1",
+			})
+			if err != nil {
+				t.Fatalf("upsert record: %v", err)
+			}
+			if !changed || sent.Content != "192.0.2.1" {
+				t.Fatalf("expected content update, got changed=%t record %#v", changed, sent)
+			}
+			if sent.TTL != tt.wantTTL || sent.Proxied == nil || *sent.Proxied != tt.wantProxied {
+				t.Fatalf("expected ttl %d proxied %t to be kept, got %#v", tt.wantTTL, tt.wantProxied, sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// DNSSEC is a zone's DNSSEC status and the DS record details to publish at the registrar.
+type DNSSEC struct {
+	Status          string `json:"status"`
+	Flags           int    `json:"flags,omitempty"`
+	Algorithm       string `json:"algorithm,omitempty"`
+	KeyType         string `json:"key_type,omitempty"`
+	DigestType      string `json:"digest_type,omitempty"`
+	DigestAlgorithm string `json:"digest_algorithm,omitempty"`
+	Digest          string `json:"digest,omitempty"`
+	DS              string `json:"ds,omitempty"`
+	KeyTag          int    `json:"key_tag,omitempty"`
+	PublicKey       string `json:"public_key,omitempty"`
+}
+
+// GetDNSSEC returns the DNSSEC status of a zone.
+func (d *DNSService) GetDNSSEC(ctx context.Context, zoneID string, reqOpts ...RequestOption) (*DNSSEC, error) {
+	return d.dnssec(ctx, zoneID, http.MethodGet, nil, reqOpts...)
+}
+
+// EnableDNSSEC enables DNSSEC for a zone. The DS record fields are populated
+// once Cloudflare has signed the zone, which may take a few minutes.
+func (d *DNSService) EnableDNSSEC(ctx context.Context, zoneID string, reqOpts ...RequestOption) (*DNSSEC, error) {
+	return d.dnssec(ctx, zoneID, http.MethodPatch, map[string]string{"status": "active"}, reqOpts...)
+}
+
+// DisableDNSSEC disables DNSSEC for a zone. Remove the DS record at the
+// registrar first to avoid resolution failures.
+func (d *DNSService) DisableDNSSEC(ctx context.Context, zoneID string, reqOpts ...RequestOption) (*DNSSEC, error) {
+	return d.dnssec(ctx, zoneID, http.MethodPatch, map[string]string{"status": "disabled"}, reqOpts...)
+}
+
+func (d *DNSService) dnssec(
+	ctx context.Context,
+	zoneID string,
+	method string,
+	requestBody any,
+	reqOpts ...RequestOption,
+) (*DNSSEC, error) {
+	prefix, err := scopePrefix(ctx, ZoneScope(zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var dnssec DNSSEC
+	endpoint := fmt.Sprintf("/%s/dnssec", prefix)
+	if err := d.client.DoWithOptions(ctx, method, endpoint, nil, requestBody, &dnssec, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &dnssec, nil
+}
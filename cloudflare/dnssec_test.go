@@ -0,0 +1,74 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDNSSECEnableAndDisable(t *testing.T) {
+	t.Parallel()
+
+	status := "disabled"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone-1/dnssec" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPatch:
+			var payload map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			status = payload["status"]
+		case http.MethodGet:
+		default:
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+
+		result := map[string]any{"status": status}
+		if status == "active" {
+			result["algorithm"] = "13"
+			result["digest_type"] = "2"
+			result["digest"] = "48E939042E82C22542CB377B580DFDC52A361CEFDC72E7F9107E2B6BD9306A45"
+			result["key_tag"] = 42
+			result["public_key"] = "oXiGYrSTO+LSCJ3mohc8EP+CzF9KxBj8/ydXJ22pKuZP3VAC3/Md/k7xZfz470CoRyZJ6gV6vml07IC3d8xqhA=="
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	enabled, err := client.DNS().EnableDNSSEC(ctx, "zone-1")
+	if err != nil {
+		t.Fatalf("enable DNSSEC: %v", err)
+	}
+	if enabled.Status != "active" || enabled.KeyTag != 42 || enabled.DigestType != "2" || enabled.PublicKey == "" {
+		t.Fatalf("unexpected DNSSEC details: %#v", enabled)
+	}
+
+	current, err := client.DNS().GetDNSSEC(ctx, "zone-1")
+	if err != nil {
+		t.Fatalf("get DNSSEC: %v", err)
+	}
+	if current.Digest != enabled.Digest {
+		t.Fatalf("unexpected DNSSEC details: %#v", current)
+	}
+
+	disabled, err := client.DNS().DisableDNSSEC(ctx, "zone-1")
+	if err != nil {
+		t.Fatalf("disable DNSSEC: %v", err)
+	}
+	if disabled.Status != "disabled" {
+		t.Fatalf("unexpected status: %s", disabled.Status)
+	}
+}
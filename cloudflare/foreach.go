@@ -0,0 +1,64 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ForEachZone lists zones and runs fn against each with bounded parallelism.
+// Errors from individual zones are aggregated with errors.Join; no new zones are
+// started once ctx is canceled.
+func (c *Client) ForEachZone(
+	ctx context.Context,
+	fn func(ctx context.Context, zone Zone) error,
+	concurrency int,
+) error {
+	if fn == nil {
+		return errors.New("zone function must not be nil")
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	zones, err := c.ListZones(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, zone := range zones {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(zone Zone) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if fnErr := fn(ctx, zone); fnErr != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("zone %s (%s): %w", zone.Name, zone.ID, fnErr))
+				mu.Unlock()
+			}
+		}(zone)
+	}
+	wg.Wait()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		errs = append(errs, ctxErr)
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,113 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestForEachZone_BoundedParallelismAndJoinedErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": []map[string]any{
+				{"id": "zone-1", "name": "one.acme.com"},
+				{"id": "zone-2", "name": "two.acme.com"},
+				{"id": "zone-3", "name": "three.acme.com"},
+				{"id": "zone-4", "name": "four.acme.com"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	errBoom := errors.New("boom")
+	var (
+		mu       sync.Mutex
+		visited  []string
+		inFlight atomic.Int32
+		peak     atomic.Int32
+	)
+	err = client.ForEachZone(context.Background(), func(_ context.Context, zone Zone) error {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		visited = append(visited, zone.ID)
+		mu.Unlock()
+
+		if zone.ID == "zone-2" || zone.ID == "zone-4" {
+			return errBoom
+		}
+		return nil
+	}, 2)
+
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected joined zone error, got: %v", err)
+	}
+	if len(visited) != 4 {
+		t.Fatalf("expected all zones to be visited, got: %v", visited)
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got: %d", peak.Load())
+	}
+}
+
+func TestForEachZone_StopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": []map[string]any{
+				{"id": "zone-1", "name": "one.acme.com"},
+				{"id": "zone-2", "name": "two.acme.com"},
+				{"id": "zone-3", "name": "three.acme.com"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int32
+	err = client.ForEachZone(ctx, func(context.Context, Zone) error {
+		calls.Add(1)
+		cancel()
+		return nil
+	}, 1)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single zone call before cancellation, got: %d", calls.Load())
+	}
+}
@@ -0,0 +1,178 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Image represents an image stored in Cloudflare Images.
+type Image struct {
+	ID                string         `json:"id"`
+	Filename          string         `json:"filename,omitempty"`
+	Metadata          map[string]any `json:"meta,omitempty"`
+	RequireSignedURLs bool           `json:"requireSignedURLs"`
+	Variants          []string       `json:"variants,omitempty"`
+	Uploaded          time.Time      `json:"uploaded,omitzero"`
+	Draft             bool           `json:"draft,omitempty"`
+}
+
+// DirectUploadOptions configures a one-time direct upload URL.
+type DirectUploadOptions struct {
+	// ID optionally sets a custom image ID instead of a generated one.
+	ID                string
+	Metadata          map[string]any
+	RequireSignedURLs bool
+	// Expiry is when the upload URL stops being valid; zero uses the API default.
+	Expiry time.Time
+}
+
+// DirectUpload is a one-time upload URL and the ID the image will be stored under.
+type DirectUpload struct {
+	ID        string `json:"id"`
+	UploadURL string `json:"uploadURL"`
+}
+
+// ImagesService provides Cloudflare Images operations.
+type ImagesService struct {
+	client *Client
+}
+
+// Images returns the Images service API.
+func (c *Client) Images() *ImagesService {
+	return &ImagesService{client: c}
+}
+
+// CreateDirectUploadURL creates a one-time URL clients can upload an image to
+// without the bytes passing through the caller.
+func (i *ImagesService) CreateDirectUploadURL(
+	ctx context.Context,
+	accountID string,
+	opts DirectUploadOptions,
+	reqOpts ...RequestOption,
+) (*DirectUpload, error) {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := opts.multipart(i.client)
+	if err != nil {
+		return nil, err
+	}
+
+	var upload DirectUpload
+	endpoint := fmt.Sprintf("/%s/images/v2/direct_upload", prefix)
+	if err := i.client.DoWithOptions(ctx, http.MethodPost, endpoint, nil, form, &upload, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &upload, nil
+}
+
+// ListImages lists a single page of images. Use WithListOptions to select the
+// page and page size.
+func (i *ImagesService) ListImages(ctx context.Context, accountID string, reqOpts ...RequestOption) ([]Image, error) {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Images []Image `json:"images"`
+	}
+	endpoint := fmt.Sprintf("/%s/images/v1", prefix)
+	if err := i.client.DoWithOptions(ctx, http.MethodGet, endpoint, nil, nil, &result, reqOpts...); err != nil {
+		return nil, err
+	}
+	return result.Images, nil
+}
+
+// GetImageDetails returns the details of a single image.
+func (i *ImagesService) GetImageDetails(
+	ctx context.Context,
+	accountID string,
+	imageID string,
+	reqOpts ...RequestOption,
+) (*Image, error) {
+	endpoint, err := imagePath(ctx, accountID, imageID)
+	if err != nil {
+		return nil, err
+	}
+
+	var image Image
+	if err := i.client.DoWithOptions(ctx, http.MethodGet, endpoint, nil, nil, &image, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &image, nil
+}
+
+// DeleteImage deletes an image and all of its variants.
+func (i *ImagesService) DeleteImage(
+	ctx context.Context,
+	accountID string,
+	imageID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := imagePath(ctx, accountID, imageID)
+	if err != nil {
+		return err
+	}
+	return i.client.DoWithOptions(ctx, http.MethodDelete, endpoint, nil, nil, nil, reqOpts...)
+}
+
+func (o DirectUploadOptions) multipart(c *Client) (multipartBody, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fields := map[string]string{
+		"requireSignedURLs": strconv.FormatBool(o.RequireSignedURLs),
+	}
+	if id := strings.TrimSpace(o.ID); id != "" {
+		fields["id"] = id
+	}
+	if len(o.Metadata) > 0 {
+		metadata, err := c.marshalJSON(o.Metadata)
+		if err != nil {
+			return multipartBody{}, fmt.Errorf("marshal image metadata: %w", err)
+		}
+		fields["metadata"] = string(metadata)
+	}
+	if !o.Expiry.IsZero() {
+		fields["expiry"] = o.Expiry.UTC().Format(time.RFC3339)
+	}
+
+	for _, name := range []string{"id", "requireSignedURLs", "metadata", "expiry"} {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+		if err := writer.WriteField(name, value); err != nil {
+			return multipartBody{}, fmt.Errorf("encode direct upload form: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return multipartBody{}, fmt.Errorf("encode direct upload form: %w", err)
+	}
+
+	return multipartBody{contentType: writer.FormDataContentType(), data: buf.Bytes()}, nil
+}
+
+func imagePath(ctx context.Context, accountID string, imageID string) (string, error) {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return "", err
+	}
+
+	cleanImageID := strings.TrimSpace(imageID)
+	if cleanImageID == "" {
+		return "", errors.New("image ID must not be empty")
+	}
+	return fmt.Sprintf("/%s/images/v1/%s", prefix, url.PathEscape(cleanImageID)), nil
+}
@@ -0,0 +1,128 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestImagesCreateDirectUploadURL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/accounts/acc-1/images/v2/direct_upload" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+			t.Fatalf("unexpected content type: %s", r.Header.Get("Content-Type"))
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("parse multipart form: %v", err)
+		}
+		if r.FormValue("requireSignedURLs") != "true" {
+			t.Fatalf("unexpected requireSignedURLs: %q", r.FormValue("requireSignedURLs"))
+		}
+		if r.FormValue("metadata") != `{"user":"u-1"}` {
+			t.Fatalf("unexpected metadata: %q", r.FormValue("metadata"))
+		}
+		if r.FormValue("expiry") != "2030-01-02T03:04:05Z" {
+			t.Fatalf("unexpected expiry: %q", r.FormValue("expiry"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  map[string]any{"id": "img-1", "uploadURL": "https://upload.imagedelivery.net/img-1"},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	upload, err := client.Images().CreateDirectUploadURL(context.Background(), "acc-1", DirectUploadOptions{
+		Metadata:          map[string]any{"user": "u-1"},
+		RequireSignedURLs: true,
+		Expiry:            time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("create direct upload URL: %v", err)
+	}
+	if upload.ID != "img-1" || upload.UploadURL != "https://upload.imagedelivery.net/img-1" {
+		t.Fatalf("unexpected upload: %#v", upload)
+	}
+}
+
+func TestImagesListAndGetDetails(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/accounts/acc-1/images/v1":
+			if r.URL.Query().Get("per_page") != "50" {
+				t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result":  map[string]any{"images": []map[string]any{{"id": "img-1"}, {"id": "img-2"}}},
+			})
+		case "/accounts/acc-1/images/v1/img-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result": map[string]any{
+					"id":                "img-1",
+					"filename":          "cat.png",
+					"requireSignedURLs": true,
+					"variants":          []string{"https://imagedelivery.net/hash/img-1/public"},
+				},
+			})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	images, err := client.Images().ListImages(context.Background(), "acc-1", WithListOptions(ListOptions{PerPage: 50}))
+	if err != nil {
+		t.Fatalf("list images: %v", err)
+	}
+	if len(images) != 2 || images[1].ID != "img-2" {
+		t.Fatalf("unexpected images: %#v", images)
+	}
+
+	image, err := client.Images().GetImageDetails(context.Background(), "acc-1", "img-1")
+	if err != nil {
+		t.Fatalf("get image details: %v", err)
+	}
+	if image.Filename != "cat.png" || !image.RequireSignedURLs || len(image.Variants) != 1 {
+		t.Fatalf("unexpected image: %#v", image)
+	}
+}
+
+func TestImagesDeleteImageValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Images().DeleteImage(context.Background(), "acc-1", " "); err == nil {
+		t.Fatalf("expected empty image ID validation error")
+	}
+}
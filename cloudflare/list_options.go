@@ -0,0 +1,98 @@
+package cloudflare
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Cloudflare accepts per_page values in this range on list endpoints.
+const (
+	minPerPage = 1
+	maxPerPage = 1000
+)
+
+// ListOptions holds the common pagination, sorting, and matching query
+// parameters accepted by Cloudflare list endpoints.
+type ListOptions struct {
+	Page      int
+	PerPage   int
+	Order     string
+	Direction string
+	Match     string
+}
+
+// ToValues validates the options and converts them to query parameters.
+// Zero-valued fields are omitted.
+func (o ListOptions) ToValues() (url.Values, error) {
+	values := url.Values{}
+
+	if o.Page < 0 {
+		return nil, fmt.Errorf("list page must not be negative: %d", o.Page)
+	}
+	if o.PerPage < 0 {
+		return nil, fmt.Errorf("list per_page must not be negative: %d", o.PerPage)
+	}
+
+	direction := strings.ToLower(strings.TrimSpace(o.Direction))
+	switch direction {
+	case "", "asc", "desc":
+	default:
+		return nil, fmt.Errorf("list direction must be asc or desc: %q", o.Direction)
+	}
+
+	match := strings.ToLower(strings.TrimSpace(o.Match))
+	switch match {
+	case "", "any", "all":
+	default:
+		return nil, fmt.Errorf("list match must be any or all: %q", o.Match)
+	}
+
+	if o.Page > 0 {
+		values.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		values.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+	if order := strings.TrimSpace(o.Order); order != "" {
+		values.Set("order", order)
+	}
+	if direction != "" {
+		values.Set("direction", direction)
+	}
+	if match != "" {
+		values.Set("match", match)
+	}
+
+	return values, nil
+}
+
+// WithListOptions applies list pagination, sorting, and matching parameters to
+// a request. Explicit params passed to the request take precedence.
+func WithListOptions(opts ListOptions) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.listOptions = &opts
+	}
+}
+
+// WithPerPage sets the per_page query parameter, clamped to Cloudflare's 1-1000
+// range. It overrides ListOptions.PerPage; an explicit per_page in the request
+// params still wins. On list helpers it applies to every page fetched.
+func WithPerPage(n int) RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.perPage = min(max(n, minPerPage), maxPerPage)
+	}
+}
+
+// mergeParams returns base overlaid with override; override wins per key.
+func mergeParams(base url.Values, override url.Values) url.Values {
+	merged := url.Values{}
+	for key, values := range base {
+		merged[key] = append([]string(nil), values...)
+	}
+	for key, values := range override {
+		merged[key] = append([]string(nil), values...)
+	}
+	return merged
+}
@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestListOptionsToValues(t *testing.T) {
+	t.Parallel()
+
+	values, err := ListOptions{
+		Page:      2,
+		PerPage:   50,
+		Order:     "name",
+		Direction: "DESC",
+		Match:     "all",
+	}.ToValues()
+	if err != nil {
+		t.Fatalf("to values: %v", err)
+	}
+	if got := values.Encode(); got != "direction=desc&match=all&order=name&page=2&per_page=50" {
+		t.Fatalf("unexpected query: %s", got)
+	}
+
+	if _, err := (ListOptions{Direction: "sideways"}).ToValues(); err == nil {
+		t.Fatalf("expected invalid direction validation error")
+	}
+	if _, err := (ListOptions{Match: "some"}).ToValues(); err == nil {
+		t.Fatalf("expected invalid match validation error")
+	}
+}
+
+func TestListZones_WithListOptions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("page") != "3" || query.Get("per_page") != "5" || query.Get("direction") != "asc" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-11","name":"eleven.acme.com"}],` +
+			`"result_info":{"page":3,"per_page":5,"total_pages":3,"count":1,"total_count":11}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(
+		context.Background(),
+		WithListOptions(ListOptions{Page: 3, PerPage: 5, Direction: "asc"}),
+	)
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if len(zones) != 1 || zones[0].ID != "zone-11" {
+		t.Fatalf("unexpected zones payload: %#v", zones)
+	}
+
+	_, err = client.ListZones(context.Background(), WithListOptions(ListOptions{Match: "bogus"}))
+	if err == nil {
+		t.Fatalf("expected invalid list options error")
+	}
+}
+
+func TestWithPerPageClamps(t *testing.T) {
+	t.Parallel()
+
+	for n, want := range map[int]int{0: 1, -5: 1, 50: 50, 1000: 1000, 5000: 1000} {
+		cfg := requestConfig{}
+		WithPerPage(n)(&cfg)
+		if cfg.perPage != want {
+			t.Fatalf("WithPerPage(%d): got %d want %d", n, cfg.perPage, want)
+		}
+	}
+}
+
+func TestListZones_WithPerPage(t *testing.T) {
+	t.Parallel()
+
+	var perPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		perPages = append(perPages, r.URL.Query().Get("per_page"))
+		w.Header().Set("Content-Type", "application/json")
+		page := r.URL.Query().Get("page")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-` + page + `"}],` +
+			`"result_info":{"page":` + page + `,"per_page":1000,"total_pages":2}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(
+		context.Background(),
+		WithListOptions(ListOptions{PerPage: 20, Order: "name"}),
+		WithPerPage(1000),
+	)
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if len(zones) != 2 || len(perPages) != 2 || perPages[0] != "1000" || perPages[1] != "1000" {
+		t.Fatalf("expected per_page=1000 on every page, got %v for %d zones", perPages, len(zones))
+	}
+}
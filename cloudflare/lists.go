@@ -0,0 +1,266 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultBulkOperationPollInterval = time.Second
+
+// Bulk operation statuses reported by GetBulkOperationStatus.
+const (
+	BulkOperationPending   = "pending"
+	BulkOperationRunning   = "running"
+	BulkOperationCompleted = "completed"
+	BulkOperationFailed    = "failed"
+)
+
+// ErrBulkOperationFailed indicates an asynchronous list operation finished
+// with status "failed". The returned error is a *BulkOperationError.
+var ErrBulkOperationFailed = errors.New("cloudflare list bulk operation failed")
+
+// BulkOperationError reports a failed bulk operation with the server message.
+type BulkOperationError struct {
+	OperationID string
+	Message     string
+}
+
+// Error implements the error interface.
+func (e *BulkOperationError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("%s: %s", ErrBulkOperationFailed, e.OperationID)
+	}
+	return fmt.Sprintf("%s: %s: %s", ErrBulkOperationFailed, e.OperationID, e.Message)
+}
+
+// Is reports whether target is ErrBulkOperationFailed.
+func (e *BulkOperationError) Is(target error) bool {
+	return target == ErrBulkOperationFailed
+}
+
+// RuleList represents a Cloudflare list referenced by firewall and other rules.
+type RuleList struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Description string `json:"description,omitempty"`
+	NumItems    int    `json:"num_items"`
+}
+
+// ListItem is an entry in an ip, hostname, or asn list. Set exactly the field
+// matching the list kind.
+type ListItem struct {
+	ID       string        `json:"id,omitempty"`
+	IP       string        `json:"ip,omitempty"`
+	Hostname *ListHostname `json:"hostname,omitempty"`
+	ASN      int           `json:"asn,omitempty"`
+	Comment  string        `json:"comment,omitempty"`
+}
+
+// ListHostname is the value of a hostname list item.
+type ListHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+// BulkOperation is the state of an asynchronous list mutation.
+type BulkOperation struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+	CompletedAt string `json:"completed,omitempty"`
+}
+
+type bulkOperationRef struct {
+	OperationID string `json:"operation_id"`
+}
+
+// ListsService provides Cloudflare IP, hostname, and ASN list operations.
+type ListsService struct {
+	client *Client
+}
+
+// Lists returns the rule lists service API.
+func (c *Client) Lists() *ListsService {
+	return &ListsService{client: c}
+}
+
+// CreateList creates an empty list of kind ip, hostname, or asn.
+func (l *ListsService) CreateList(
+	ctx context.Context,
+	accountID string,
+	name string,
+	kind string,
+	reqOpts ...RequestOption,
+) (*RuleList, error) {
+	endpoint, err := listsPath(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	cleanName := strings.TrimSpace(name)
+	if cleanName == "" {
+		return nil, errors.New("list name must not be empty")
+	}
+	switch kind {
+	case "ip", "hostname", "asn":
+	default:
+		return nil, fmt.Errorf("list kind must be ip, hostname, or asn, got %q", kind)
+	}
+
+	var list RuleList
+	body := map[string]string{"name": cleanName, "kind": kind}
+	if err := l.client.DoWithOptions(ctx, http.MethodPost, endpoint, nil, body, &list, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// AddItems appends items to a list and returns the ID of the asynchronous
+// bulk operation applying them; see WaitForBulkOperation.
+func (l *ListsService) AddItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	items []ListItem,
+	reqOpts ...RequestOption,
+) (string, error) {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return "", err
+	}
+	if len(items) == 0 {
+		return "", errors.New("list items must not be empty")
+	}
+
+	var ref bulkOperationRef
+	if err := l.client.DoWithOptions(ctx, http.MethodPost, endpoint, nil, items, &ref, reqOpts...); err != nil {
+		return "", err
+	}
+	return ref.OperationID, nil
+}
+
+// DeleteItems removes items by ID and returns the ID of the asynchronous bulk
+// operation applying the removal.
+func (l *ListsService) DeleteItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	itemIDs []string,
+	reqOpts ...RequestOption,
+) (string, error) {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return "", err
+	}
+	if len(itemIDs) == 0 {
+		return "", errors.New("list item IDs must not be empty")
+	}
+
+	refs := make([]map[string]string, len(itemIDs))
+	for i, id := range itemIDs {
+		cleanID := strings.TrimSpace(id)
+		if cleanID == "" {
+			return "", fmt.Errorf("list item ID %d must not be empty", i)
+		}
+		refs[i] = map[string]string{"id": cleanID}
+	}
+
+	var ref bulkOperationRef
+	body := map[string]any{"items": refs}
+	if err := l.client.DoWithOptions(ctx, http.MethodDelete, endpoint, nil, body, &ref, reqOpts...); err != nil {
+		return "", err
+	}
+	return ref.OperationID, nil
+}
+
+// ListItems returns every item in a list, following result cursors.
+func (l *ListsService) ListItems(
+	ctx context.Context,
+	accountID string,
+	listID string,
+	reqOpts ...RequestOption,
+) ([]ListItem, error) {
+	endpoint, err := listItemsPath(ctx, accountID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	return listAllPages[ListItem](ctx, l.client, endpoint, nil, "list items", reqOpts...)
+}
+
+// GetBulkOperationStatus fetches the state of an asynchronous list operation.
+func (l *ListsService) GetBulkOperationStatus(
+	ctx context.Context,
+	accountID string,
+	operationID string,
+	reqOpts ...RequestOption,
+) (*BulkOperation, error) {
+	collection, err := listsPath(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	cleanOperationID := strings.TrimSpace(operationID)
+	if cleanOperationID == "" {
+		return nil, errors.New("bulk operation ID must not be empty")
+	}
+
+	var op BulkOperation
+	endpoint := fmt.Sprintf("%s/bulk_operations/%s", collection, url.PathEscape(cleanOperationID))
+	if err := l.client.DoWithOptions(ctx, http.MethodGet, endpoint, nil, nil, &op, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+// WaitForBulkOperation polls a bulk operation every poll interval until it
+// completes or fails, or ctx is done. A failed operation is reported as
+// a *BulkOperationError along with the final state.
+func (l *ListsService) WaitForBulkOperation(
+	ctx context.Context,
+	accountID string,
+	operationID string,
+	poll time.Duration,
+) (*BulkOperation, error) {
+	if poll <= 0 {
+		poll = defaultBulkOperationPollInterval
+	}
+
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+
+	for {
+		op, err := l.GetBulkOperationStatus(ctx, accountID, operationID)
+		if err != nil {
+			return nil, err
+		}
+		switch op.Status {
+		case BulkOperationCompleted:
+			return op, nil
+		case BulkOperationFailed:
+			return op, &BulkOperationError{OperationID: operationID, Message: op.Error}
+		}
+
+		select {
+		case <-ctx.Done():
+			return op, fmt.Errorf("wait for bulk operation %s: %w", operationID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForBulkOperation polls an asynchronous list operation, such as one
+// returned by ListsService.AddItems, until it completes. A failed operation is
+// reported as a *BulkOperationError carrying the server message.
+func (c *Client) WaitForBulkOperation(
+	ctx context.Context,
+	accountID string,
+	operationID string,
+	poll time.Duration,
+) error {
+	_, err := c.Lists().WaitForBulkOperation(ctx, accountID, operationID, poll)
+	return err
+}
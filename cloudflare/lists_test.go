@@ -0,0 +1,180 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListsLifecycle(t *testing.T) {
+	t.Parallel()
+
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/rules/lists":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if body["kind"] != "ip" || body["name"] != "blocked" {
+				t.Fatalf("unexpected create body: %#v", body)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "list-1", "name": "blocked", "kind": "ip"}})
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/rules/lists/list-1/items":
+			var items []ListItem
+			if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if len(items) != 2 || items[0].IP != "192.0.2.1" {
+				t.Fatalf("unexpected items: %#v", items)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"operation_id": "op-1"}})
+		case r.Method == http.MethodDelete && r.URL.Path == "/accounts/acc-1/rules/lists/list-1/items":
+			var body struct {
+				Items []map[string]string `json:"items"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if len(body.Items) != 1 || body.Items[0]["id"] != "item-1" {
+				t.Fatalf("unexpected delete body: %#v", body)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"operation_id": "op-2"}})
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/rules/lists/list-1/items":
+			result := map[string]any{"success": true}
+			if r.URL.Query().Get("cursor") == "" {
+				result["result"] = []map[string]any{{"id": "item-1", "ip": "192.0.2.1"}}
+				result["result_info"] = map[string]any{"cursors": map[string]any{"after": "next"}}
+			} else {
+				result["result"] = []map[string]any{{"id": "item-2", "ip": "192.0.2.0/24"}}
+			}
+			_ = json.NewEncoder(w).Encode(result)
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/rules/lists/bulk_operations/op-1":
+			status := BulkOperationPending
+			if polls.Add(1) > 1 {
+				status = BulkOperationCompleted
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "op-1", "status": status}})
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	list, err := client.Lists().CreateList(ctx, "acc-1", "blocked", "ip")
+	if err != nil {
+		t.Fatalf("create list: %v", err)
+	}
+
+	opID, err := client.Lists().AddItems(ctx, "acc-1", list.ID, []ListItem{{IP: "192.0.2.1"}, {IP: "192.0.2.0/24", Comment: "range"}})
+	if err != nil {
+		t.Fatalf("add items: %v", err)
+	}
+	op, err := client.Lists().WaitForBulkOperation(ctx, "acc-1", opID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("wait for bulk operation: %v", err)
+	}
+	if op.Status != BulkOperationCompleted || polls.Load() != 2 {
+		t.Fatalf("unexpected operation %#v after %d polls", op, polls.Load())
+	}
+
+	items, err := client.Lists().ListItems(ctx, "acc-1", list.ID)
+	if err != nil {
+		t.Fatalf("list items: %v", err)
+	}
+	if len(items) != 2 || items[1].IP != "192.0.2.0/24" {
+		t.Fatalf("unexpected items: %#v", items)
+	}
+
+	if opID, err := client.Lists().DeleteItems(ctx, "acc-1", list.ID, []string{"item-1"}); err != nil || opID != "op-2" {
+		t.Fatalf("delete items: %q %v", opID, err)
+	}
+}
+
+func TestListsWaitForFailedBulkOperation(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  map[string]any{"id": "op-1", "status": "failed", "error": "invalid IP"},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.WaitForBulkOperation(context.Background(), "acc-1", "op-1", time.Millisecond)
+	if !errors.Is(err, ErrBulkOperationFailed) {
+		t.Fatalf("expected ErrBulkOperationFailed, got %v", err)
+	}
+	var opErr *BulkOperationError
+	if !errors.As(err, &opErr) || opErr.OperationID != "op-1" || opErr.Message != "invalid IP" {
+		t.Fatalf("expected BulkOperationError with server message, got %#v", err)
+	}
+}
+
+func TestListsValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.Lists().CreateList(ctx, "acc-1", "blocked", "redirect"); err == nil {
+		t.Fatalf("expected list kind validation error")
+	}
+	if _, err := client.Lists().AddItems(ctx, "acc-1", "list-1", nil); err == nil {
+		t.Fatalf("expected empty items validation error")
+	}
+	if _, err := client.Lists().DeleteItems(ctx, "acc-1", "list-1", []string{" "}); err == nil {
+		t.Fatalf("expected empty item ID validation error")
+	}
+	if _, err := client.Lists().GetBulkOperationStatus(ctx, "acc-1", ""); err == nil {
+		t.Fatalf("expected empty operation ID validation error")
+	}
+}
+
+func TestWaitForBulkOperationHonorsContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result":  map[string]any{"id": "op-1", "status": BulkOperationRunning},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = client.WaitForBulkOperation(ctx, "acc-1", "op-1", time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMagicTransitStaticRoutes(t *testing.T) {
+	t.Parallel()
+
+	route := map[string]any{
+		"id":       "route-1",
+		"prefix":   "192.0.2.0/24",
+		"nexthop":  "10.0.0.1",
+		"priority": 100,
+		"scope":    map[string]any{"colo_names": []string{"den01"}},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result any
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/accounts/acc-1/magic/routes":
+			var payload StaticRoute
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			if payload.Prefix != "192.0.2.0/24" || payload.Scope == nil || payload.Scope.ColoNames[0] != "den01" {
+				t.Fatalf("unexpected route payload: %#v", payload)
+			}
+			result = map[string]any{"routes": []any{route}}
+		case r.Method == http.MethodGet && r.URL.Path == "/accounts/acc-1/magic/routes":
+			result = map[string]any{"routes": []any{route}}
+		case r.Method == http.MethodPut && r.URL.Path == "/accounts/acc-1/magic/routes/route-1":
+			result = map[string]any{"modified": true, "modified_route": route}
+		case r.Method == http.MethodDelete && r.URL.Path == "/accounts/acc-1/magic/routes/route-1":
+			result = map[string]any{"deleted": true, "deleted_route": route}
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	magic := client.MagicTransit()
+	ctx := context.Background()
+	desired := StaticRoute{
+		Prefix:   "192.0.2.0/24",
+		Nexthop:  "10.0.0.1",
+		Priority: 100,
+		Scope:    &StaticRouteScope{ColoNames: []string{"den01"}},
+	}
+
+	var created StaticRoute
+	if err := magic.CreateStaticRoute(ctx, "acc-1", desired, &created); err != nil {
+		t.Fatalf("create static route: %v", err)
+	}
+	if created.ID != "route-1" || created.Priority != 100 {
+		t.Fatalf("unexpected created route: %#v", created)
+	}
+
+	routes, err := magic.ListStaticRoutes(ctx, "acc-1")
+	if err != nil {
+		t.Fatalf("list static routes: %v", err)
+	}
+	if len(routes) != 1 || routes[0].Nexthop != "10.0.0.1" {
+		t.Fatalf("unexpected routes: %#v", routes)
+	}
+
+	var updated StaticRoute
+	if err := magic.UpdateStaticRoute(ctx, "acc-1", "route-1", desired, &updated); err != nil {
+		t.Fatalf("update static route: %v", err)
+	}
+	if updated.ID != "route-1" {
+		t.Fatalf("unexpected updated route: %#v", updated)
+	}
+
+	if err := magic.DeleteStaticRoute(ctx, "acc-1", "route-1"); err != nil {
+		t.Fatalf("delete static route: %v", err)
+	}
+}
+
+func TestMagicTransitStaticRouteValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	magic := client.MagicTransit()
+	ctx := context.Background()
+
+	if err := magic.CreateStaticRoute(ctx, "acc-1", StaticRoute{Nexthop: "10.0.0.1"}, nil); err == nil {
+		t.Fatalf("expected empty prefix validation error")
+	}
+	if err := magic.UpdateStaticRoute(ctx, "acc-1", " ", StaticRoute{Prefix: "192.0.2.0/24", Nexthop: "10.0.0.1"}, nil); err == nil {
+		t.Fatalf("expected empty route ID validation error")
+	}
+	if err := magic.DeleteStaticRoute(ctx, "", "route-1"); err == nil {
+		t.Fatalf("expected empty account ID validation error")
+	}
+}
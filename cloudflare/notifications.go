@@ -0,0 +1,201 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// NotificationMechanism identifies a single delivery target for a notification policy.
+type NotificationMechanism struct {
+	ID string `json:"id"`
+}
+
+// NotificationPolicy represents a Cloudflare alerting (notification) policy.
+type NotificationPolicy struct {
+	ID          string                             `json:"id,omitempty"`
+	Name        string                             `json:"name"`
+	Description string                             `json:"description,omitempty"`
+	AlertType   string                             `json:"alert_type"`
+	Enabled     bool                               `json:"enabled"`
+	Mechanisms  map[string][]NotificationMechanism `json:"mechanisms"`
+	Filters     map[string][]string                `json:"filters,omitempty"`
+}
+
+// NotificationWebhook represents a Cloudflare alerting webhook destination.
+type NotificationWebhook struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"`
+	Type   string `json:"type,omitempty"`
+}
+
+// NotificationsService provides Cloudflare alerting policy and destination operations.
+type NotificationsService struct {
+	client *Client
+}
+
+// Notifications returns the Notifications service API.
+func (c *Client) Notifications() *NotificationsService {
+	return &NotificationsService{client: c}
+}
+
+// CreatePolicy creates a notification policy for an account.
+func (n *NotificationsService) CreatePolicy(
+	ctx context.Context,
+	accountID string,
+	policy NotificationPolicy,
+	out *NotificationPolicy,
+	reqOpts ...RequestOption,
+) error {
+	return n.do(ctx, accountID, http.MethodPost, "/policies", policy, policyOut(out), reqOpts...)
+}
+
+// ListPolicies lists notification policies for an account across all pages.
+func (n *NotificationsService) ListPolicies(
+	ctx context.Context,
+	accountID string,
+	reqOpts ...RequestOption,
+) ([]NotificationPolicy, error) {
+	endpoint, err := notificationsPath(ctx, accountID, "/policies")
+	if err != nil {
+		return nil, err
+	}
+	return listAllPages[NotificationPolicy](ctx, n.client, endpoint, nil, "notification policy list", reqOpts...)
+}
+
+// UpdatePolicy replaces an existing notification policy.
+func (n *NotificationsService) UpdatePolicy(
+	ctx context.Context,
+	accountID string,
+	policyID string,
+	policy NotificationPolicy,
+	out *NotificationPolicy,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := notificationsItemPath("/policies", "policy", policyID)
+	if err != nil {
+		return err
+	}
+	return n.do(ctx, accountID, http.MethodPut, endpoint, policy, policyOut(out), reqOpts...)
+}
+
+// DeletePolicy deletes a notification policy.
+func (n *NotificationsService) DeletePolicy(
+	ctx context.Context,
+	accountID string,
+	policyID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := notificationsItemPath("/policies", "policy", policyID)
+	if err != nil {
+		return err
+	}
+	return n.do(ctx, accountID, http.MethodDelete, endpoint, nil, nil, reqOpts...)
+}
+
+// CreateWebhook creates a webhook destination for notification policies.
+func (n *NotificationsService) CreateWebhook(
+	ctx context.Context,
+	accountID string,
+	webhook NotificationWebhook,
+	out *NotificationWebhook,
+	reqOpts ...RequestOption,
+) error {
+	return n.do(ctx, accountID, http.MethodPost, "/destinations/webhooks", webhook, webhookOut(out), reqOpts...)
+}
+
+// ListWebhooks lists webhook destinations for an account across all pages.
+func (n *NotificationsService) ListWebhooks(
+	ctx context.Context,
+	accountID string,
+	reqOpts ...RequestOption,
+) ([]NotificationWebhook, error) {
+	endpoint, err := notificationsPath(ctx, accountID, "/destinations/webhooks")
+	if err != nil {
+		return nil, err
+	}
+	return listAllPages[NotificationWebhook](ctx, n.client, endpoint, nil, "notification webhook list", reqOpts...)
+}
+
+// UpdateWebhook replaces an existing webhook destination.
+func (n *NotificationsService) UpdateWebhook(
+	ctx context.Context,
+	accountID string,
+	webhookID string,
+	webhook NotificationWebhook,
+	out *NotificationWebhook,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := notificationsItemPath("/destinations/webhooks", "webhook", webhookID)
+	if err != nil {
+		return err
+	}
+	return n.do(ctx, accountID, http.MethodPut, endpoint, webhook, webhookOut(out), reqOpts...)
+}
+
+// DeleteWebhook deletes a webhook destination.
+func (n *NotificationsService) DeleteWebhook(
+	ctx context.Context,
+	accountID string,
+	webhookID string,
+	reqOpts ...RequestOption,
+) error {
+	endpoint, err := notificationsItemPath("/destinations/webhooks", "webhook", webhookID)
+	if err != nil {
+		return err
+	}
+	return n.do(ctx, accountID, http.MethodDelete, endpoint, nil, nil, reqOpts...)
+}
+
+func (n *NotificationsService) do(
+	ctx context.Context,
+	accountID string,
+	method string,
+	endpoint string,
+	requestBody any,
+	out any,
+	reqOpts ...RequestOption,
+) error {
+	path, err := notificationsPath(ctx, accountID, endpoint)
+	if err != nil {
+		return err
+	}
+	return n.client.DoWithOptions(ctx, method, path, nil, requestBody, out, reqOpts...)
+}
+
+// notificationsPath returns the alerting API path for endpoint in an account.
+func notificationsPath(ctx context.Context, accountID string, endpoint string) (string, error) {
+	prefix, err := scopePrefix(ctx, AccountScope(accountID))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/%s/alerting/v3%s", prefix, endpoint), nil
+}
+
+// policyOut keeps a nil out from reaching DoWithOptions as a typed nil pointer.
+func policyOut(out *NotificationPolicy) any {
+	if out == nil {
+		return nil
+	}
+	return out
+}
+
+// webhookOut keeps a nil out from reaching DoWithOptions as a typed nil pointer.
+func webhookOut(out *NotificationWebhook) any {
+	if out == nil {
+		return nil
+	}
+	return out
+}
+
+func notificationsItemPath(collection string, kind string, id string) (string, error) {
+	cleanID := strings.TrimSpace(id)
+	if cleanID == "" {
+		return "", fmt.Errorf("%s ID must not be empty", kind)
+	}
+	return fmt.Sprintf("%s/%s", collection, url.PathEscape(cleanID)), nil
+}
@@ -0,0 +1,178 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNotificationsCreatePolicy(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/accounts/acc-1/alerting/v3/policies" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		var payload NotificationPolicy
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if payload.AlertType != "dos_attack_l7" || !payload.Enabled {
+			t.Fatalf("unexpected policy payload: %#v", payload)
+		}
+		if payload.Mechanisms["email"][0].ID != "ops@acme.com" {
+			t.Fatalf("unexpected policy mechanisms: %#v", payload.Mechanisms)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": map[string]any{
+				"id": "pol-1",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var out NotificationPolicy
+	err = client.Notifications().CreatePolicy(
+		context.Background(),
+		"acc-1",
+		NotificationPolicy{
+			Name:      "ddos",
+			AlertType: "dos_attack_l7",
+			Enabled:   true,
+			Mechanisms: map[string][]NotificationMechanism{
+				"email": {{ID: "ops@acme.com"}},
+			},
+			Filters: map[string][]string{"zones": {"zone-1"}},
+		},
+		&out,
+	)
+	if err != nil {
+		t.Fatalf("create policy: %v", err)
+	}
+	if out.ID != "pol-1" {
+		t.Fatalf("unexpected response payload: %#v", out)
+	}
+}
+
+func TestNotificationsDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/accounts/acc-1/alerting/v3/destinations/webhooks/wh-1" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":null}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Notifications().DeleteWebhook(context.Background(), "acc-1", "wh-1"); err != nil {
+		t.Fatalf("delete webhook: %v", err)
+	}
+
+	if err := client.Notifications().DeleteWebhook(context.Background(), "acc-1", " "); err == nil {
+		t.Fatalf("expected empty webhook ID validation error")
+	}
+}
+
+func TestNotificationsNilOut(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{"id":"id-1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	notifications := client.Notifications()
+	ctx := context.Background()
+
+	policy := NotificationPolicy{Name: "ddos", AlertType: "dos_attack_l7"}
+	if err := notifications.CreatePolicy(ctx, "acc-1", policy, nil); err != nil {
+		t.Fatalf("create policy: %v", err)
+	}
+	if err := notifications.UpdatePolicy(ctx, "acc-1", "pol-1", policy, nil); err != nil {
+		t.Fatalf("update policy: %v", err)
+	}
+
+	webhook := NotificationWebhook{Name: "ops", URL: "https://hooks.acme.com/cf"}
+	if err := notifications.CreateWebhook(ctx, "acc-1", webhook, nil); err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+	if err := notifications.UpdateWebhook(ctx, "acc-1", "wh-1", webhook, nil); err != nil {
+		t.Fatalf("update webhook: %v", err)
+	}
+}
+
+func TestNotificationsListPaginates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/accounts/acc-1/alerting/v3/policies" &&
+			r.URL.Path != "/accounts/acc-1/alerting/v3/destinations/webhooks" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": "id-" + page}},
+			"result_info": map[string]any{"total_pages": 2},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	policies, err := client.Notifications().ListPolicies(ctx, "acc-1")
+	if err != nil {
+		t.Fatalf("list policies: %v", err)
+	}
+	if len(policies) != 2 || policies[1].ID != "id-2" {
+		t.Fatalf("unexpected policies: %#v", policies)
+	}
+
+	webhooks, err := client.Notifications().ListWebhooks(ctx, "acc-1", WithMaxPages(1))
+	if err != nil {
+		t.Fatalf("list webhooks: %v", err)
+	}
+	if len(webhooks) != 1 || webhooks[0].ID != "id-1" {
+		t.Fatalf("expected WithMaxPages to stop after one page, got: %#v", webhooks)
+	}
+}
@@ -0,0 +1,338 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// maxListPrealloc caps slice pre-allocation so a bogus total_count cannot
+// trigger a huge allocation.
+const maxListPrealloc = 10000
+
+// maxParallelPages caps the total_pages trusted for a parallel walk. Lists
+// reporting more pages are walked sequentially, so a bogus total_pages cannot
+// fan out unbounded requests.
+const maxParallelPages = 1000
+
+// listAllPages fetches every page of a paginated GET endpoint and aggregates
+// results in page order, stopping at total_pages or an empty after cursor, at
+// an empty page, or early when WithMaxPages is set. The context is checked
+// between pages. The label is used in error messages.
+func listAllPages[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	baseParams url.Values,
+	label string,
+	reqOpts ...RequestOption,
+) ([]T, error) {
+	all, _, err := listAllPagesWithInfo[T](ctx, c, endpoint, baseParams, label, reqOpts...)
+	return all, err
+}
+
+// listAllPagesWithInfo is listAllPages that also returns the last page's
+// ResultInfo, or nil when the endpoint does not return one.
+func listAllPagesWithInfo[T any](
+	ctx context.Context,
+	c *Client,
+	endpoint string,
+	baseParams url.Values,
+	label string,
+	reqOpts ...RequestOption,
+) ([]T, *ResultInfo, error) {
+	var all []T
+	info, err := c.forEachPage(ctx, http.MethodGet, endpoint, baseParams, label, func(env *envelope) (bool, error) {
+		var pageItems []T
+		if !isEmptyResult(env.Result) {
+			if err := c.unmarshalJSON(env.Result, &pageItems); err != nil {
+				return false, fmt.Errorf("decode cloudflare %s: %w", label, err)
+			}
+		}
+		if all == nil {
+			all = make([]T, 0, preallocCapacity(env.ResultInfo, len(pageItems)))
+		}
+		all = append(all, pageItems...)
+		return len(pageItems) > 0, nil
+	}, reqOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return all, info, nil
+}
+
+// Paginate walks every page of a list endpoint, calling each with the raw
+// result array of one page at a time so large result sets are never held in
+// memory at once. Page-numbered and cursor-paginated endpoints are both
+// supported. It stops at the last page, at an empty page, when each returns an
+// error, or after WithMaxPages pages, and checks ctx between pages. Pages are
+// always fetched sequentially, even with WithParallelPagination.
+func (c *Client) Paginate(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	params url.Values,
+	each func(page json.RawMessage) error,
+	reqOpts ...RequestOption,
+) error {
+	if each == nil {
+		return errors.New("page callback must not be nil")
+	}
+
+	_, err := c.forEachPage(ctx, method, endpoint, params, endpoint, func(env *envelope) (bool, error) {
+		if isEmptyResult(env.Result) {
+			return false, nil
+		}
+		return true, each(env.Result)
+	}, append(slices.Clip(reqOpts), sequentialPages())...)
+	return err
+}
+
+// errStopIteration ends a page walk when the consumer of PaginateItems stops
+// ranging.
+var errStopIteration = errors.New("iteration stopped")
+
+// PaginateItems returns an iterator over every item of a GET list endpoint, for
+// use with range:
+//
+//	for item, err := range client.PaginateItems(ctx, "/zones", nil) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+//
+// Pages are fetched sequentially as the loop advances, so only one page is
+// held in memory, and breaking out of the loop stops further requests. A
+// failure is yielded once as the final element. It has the same paging rules
+// as Paginate, which keeps its callback signature.
+func (c *Client) PaginateItems(
+	ctx context.Context,
+	endpoint string,
+	params url.Values,
+	reqOpts ...RequestOption,
+) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		_, err := c.forEachPage(ctx, http.MethodGet, endpoint, params, endpoint, func(env *envelope) (bool, error) {
+			if isEmptyResult(env.Result) {
+				return false, nil
+			}
+			var items []json.RawMessage
+			if err := c.unmarshalJSON(env.Result, &items); err != nil {
+				return false, fmt.Errorf("decode cloudflare %s page: %w", endpoint, err)
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return false, errStopIteration
+				}
+			}
+			return len(items) > 0, nil
+		}, append(slices.Clip(reqOpts), sequentialPages())...)
+		if err != nil && !errors.Is(err, errStopIteration) {
+			yield(nil, err)
+		}
+	}
+}
+
+// sequentialPages opts a walk out of WithParallelPagination.
+func sequentialPages() RequestOption {
+	return func(cfg *requestConfig) {
+		cfg.sequentialPages = true
+	}
+}
+
+// forEachPage requests successive pages of a list endpoint and hands each
+// envelope to fn, which reports whether the page held any items. Endpoints
+// whose responses carry result_info.cursors are followed by cursor until the
+// after cursor is empty; others are walked by page number until total_pages.
+// With WithParallelPagination, page-numbered walks of up to maxParallelPages
+// pages fetch the pages after the first concurrently, a window of workers at
+// a time, and still hand them to fn in order. It returns the last page's
+// ResultInfo. The label is used in error messages.
+func (c *Client) forEachPage(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	baseParams url.Values,
+	label string,
+	fn func(env *envelope) (bool, error),
+	reqOpts ...RequestOption,
+) (*ResultInfo, error) {
+	cfg := requestConfig{}
+	for _, opt := range reqOpts {
+		opt(&cfg)
+	}
+
+	var info *ResultInfo
+	page := 1
+	if cfg.listOptions != nil && cfg.listOptions.Page > 0 {
+		page = cfg.listOptions.Page
+	}
+	cursor := ""
+
+	for fetched := 1; ; fetched++ {
+		env, err := c.doEnvelope(ctx, method, endpoint, pageParams(baseParams, page, cursor), nil, reqOpts...)
+		if err != nil {
+			return nil, err
+		}
+		hasItems, err := fn(env)
+		if err != nil {
+			return nil, err
+		}
+		info = env.ResultInfo
+
+		next, more := nextPage(env.ResultInfo, page, cursor)
+		// An empty page ends the walk even if the metadata claims more, so a
+		// shrinking list cannot cause requests past the end.
+		if !more || !hasItems {
+			break
+		}
+		if cfg.maxPages > 0 && fetched >= cfg.maxPages {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("list cloudflare %s page %d: %w", label, fetched+1, err)
+		}
+		if env.ResultInfo.Cursors != nil {
+			cursor = next
+			continue
+		}
+		if c.cfg.ParallelPagination > 1 && !cfg.sequentialPages && env.ResultInfo.TotalPages <= maxParallelPages {
+			last := env.ResultInfo.TotalPages
+			if cfg.maxPages > 0 {
+				last = min(last, page+cfg.maxPages-fetched)
+			}
+			return c.walkPagesParallel(ctx, method, endpoint, baseParams, label, page+1, last, info, fn, reqOpts...)
+		}
+		page++
+	}
+
+	return info, nil
+}
+
+// walkPagesParallel requests pages first through last in windows of
+// Config.ParallelPagination pages and hands each envelope to fn in page
+// order. Like the sequential walk it stops at the first empty page, so only
+// one window is requested past the end of a list that shrank. It returns the
+// last page's ResultInfo, or info when no page was fetched. The label is used
+// in error messages.
+func (c *Client) walkPagesParallel(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	baseParams url.Values,
+	label string,
+	first int,
+	last int,
+	info *ResultInfo,
+	fn func(env *envelope) (bool, error),
+	reqOpts ...RequestOption,
+) (*ResultInfo, error) {
+	for start := first; start <= last; start += c.cfg.ParallelPagination {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("list cloudflare %s page %d: %w", label, start, err)
+		}
+		end := min(last, start+c.cfg.ParallelPagination-1)
+		envs, err := c.fetchPages(ctx, method, endpoint, baseParams, start, end, reqOpts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range envs {
+			hasItems, err := fn(env)
+			if err != nil {
+				return nil, err
+			}
+			info = env.ResultInfo
+			if !hasItems {
+				return info, nil
+			}
+		}
+	}
+	return info, nil
+}
+
+// fetchPages requests pages first through last concurrently and returns their
+// envelopes in page order. The first failure cancels the remaining requests.
+func (c *Client) fetchPages(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	baseParams url.Values,
+	first int,
+	last int,
+	reqOpts ...RequestOption,
+) ([]*envelope, error) {
+	envs := make([]*envelope, last-first+1)
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i := range envs {
+		group.Go(func() error {
+			env, err := c.doEnvelope(groupCtx, method, endpoint, pageParams(baseParams, first+i, ""), nil, reqOpts...)
+			if err != nil {
+				return err
+			}
+			envs[i] = env
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+	return envs, nil
+}
+
+// pageParams copies baseParams and adds the cursor, or the page number when
+// cursor is empty.
+func pageParams(baseParams url.Values, page int, cursor string) url.Values {
+	params := url.Values{}
+	for key, values := range baseParams {
+		params[key] = append([]string(nil), values...)
+	}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	} else {
+		params.Set("page", strconv.Itoa(page))
+	}
+	return params
+}
+
+// nextPage reports whether another page follows and, for cursor-paginated
+// responses, the cursor to request it with. A repeated cursor ends the walk
+// so a misbehaving endpoint cannot loop forever.
+func nextPage(info *ResultInfo, page int, cursor string) (string, bool) {
+	switch {
+	case info == nil:
+		return "", false
+	case info.Cursors != nil:
+		after := info.Cursors.After
+		return after, after != "" && after != cursor
+	default:
+		return "", info.TotalPages > page
+	}
+}
+
+// isEmptyResult reports whether a raw result holds no items.
+func isEmptyResult(result json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(result)
+	return len(trimmed) == 0 || string(trimmed) == "null" || string(trimmed) == "[]"
+}
+
+// preallocCapacity returns the slice capacity to reserve for a paginated list
+// based on the first page's total_count, bounded by maxListPrealloc.
+func preallocCapacity(info *ResultInfo, firstPageLen int) int {
+	if info == nil || info.TotalCount <= firstPageLen {
+		return firstPageLen
+	}
+	if info.TotalCount > maxListPrealloc {
+		return maxListPrealloc
+	}
+	return info.TotalCount
+}
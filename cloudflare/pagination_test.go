@@ -0,0 +1,499 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestListAllPages_MissingResultInfoIsSinglePage(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1","name":"one.acme.com"},{"id":"zone-2","name":"two.acme.com"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request without result_info, got: %d", calls)
+	}
+	if len(zones) != 2 || zones[1].ID != "zone-2" {
+		t.Fatalf("unexpected zones payload: %#v", zones)
+	}
+}
+
+func TestPreallocCapacity(t *testing.T) {
+	t.Parallel()
+
+	if got := preallocCapacity(nil, 5); got != 5 {
+		t.Fatalf("nil result_info: got=%d want=5", got)
+	}
+	if got := preallocCapacity(&ResultInfo{TotalCount: 250}, 50); got != 250 {
+		t.Fatalf("total_count: got=%d want=250", got)
+	}
+	if got := preallocCapacity(&ResultInfo{TotalCount: 1 << 40}, 50); got != maxListPrealloc {
+		t.Fatalf("bogus total_count: got=%d want=%d", got, maxListPrealloc)
+	}
+}
+
+func TestListZones_SinglePage(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1","name":"one.acme.com"}],` +
+			`"result_info":{"page":1,"per_page":1,"total_pages":5,"count":1,"total_count":5}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(context.Background(), WithSinglePage())
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request, got: %d", calls)
+	}
+	if len(zones) != 1 {
+		t.Fatalf("expected 1 zone, got: %d", len(zones))
+	}
+}
+
+func TestListAllPages_StopsOnEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1"}],"result_info":{"page":1,"total_pages":5}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true,"result":[],"result_info":{"page":2,"total_pages":5}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if calls != 2 || len(zones) != 1 {
+		t.Fatalf("expected to stop after the empty page, got %d calls and %d zones", calls, len(zones))
+	}
+}
+
+func TestListAllPages_StopsWhenContextCanceledBetweenPages(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		cancel()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1"}],"result_info":{"page":1,"total_pages":3}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	_, err = client.ListZones(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no requests after cancellation, got %d", calls)
+	}
+}
+
+func TestPaginate_CallsEachPerPage(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("type") != "A" || r.URL.Query().Get("per_page") != "2" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": fmt.Sprintf("rec-%d-a", page)}, {"id": fmt.Sprintf("rec-%d-b", page)}},
+			"result_info": map[string]any{"page": page, "per_page": 2, "total_pages": 3},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var ids []string
+	pages := 0
+	err = client.Paginate(context.Background(), http.MethodGet, "/zones/zone-1/dns_records", url.Values{"type": {"A"}},
+		func(page json.RawMessage) error {
+			pages++
+			var records []DNSRecord
+			if err := json.Unmarshal(page, &records); err != nil {
+				return err
+			}
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+			return nil
+		}, WithPerPage(2))
+	if err != nil {
+		t.Fatalf("paginate: %v", err)
+	}
+	if pages != 3 || len(ids) != 6 || ids[5] != "rec-3-b" {
+		t.Fatalf("unexpected walk: %d pages, ids %v", pages, ids)
+	}
+}
+
+func TestPaginate_StopsOnCallbackError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"x"}],"result_info":{"page":1,"total_pages":5}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	stop := errors.New("stop")
+	err = client.Paginate(context.Background(), http.MethodGet, "/zones", nil, func(json.RawMessage) error { return stop })
+	if !errors.Is(err, stop) || calls.Load() != 1 {
+		t.Fatalf("expected callback error after one page, got %v after %d calls", err, calls.Load())
+	}
+}
+
+func TestPaginateItems(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 3 {
+			http.Error(w, `{"success":false,"errors":[{"code":1000,"message":"boom"}]}`, http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": fmt.Sprintf("zone-%d-a", page)}, {"id": fmt.Sprintf("zone-%d-b", page)}},
+			"result_info": map[string]any{"page": page, "per_page": 2, "total_pages": 3},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var ids []string
+	var iterErr error
+	for item, err := range client.PaginateItems(context.Background(), "/zones", nil) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		var zone Zone
+		if err := json.Unmarshal(item, &zone); err != nil {
+			t.Fatalf("decode item: %v", err)
+		}
+		ids = append(ids, zone.ID)
+	}
+	if len(ids) != 4 || ids[3] != "zone-2-b" {
+		t.Fatalf("unexpected items: %v", ids)
+	}
+	var statusErr *HTTPStatusError
+	if !errors.As(iterErr, &statusErr) {
+		t.Fatalf("expected the page 3 failure to be yielded, got %v", iterErr)
+	}
+
+	calls.Store(0)
+	for range client.PaginateItems(context.Background(), "/zones", nil) {
+		break
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected breaking out to stop after one request, got %d", got)
+	}
+}
+
+func TestListAllPages_FollowsCursors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		switch query.Get("cursor") {
+		case "":
+			if query.Get("page") != "1" {
+				t.Fatalf("expected first request to use page 1, got %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"a"}],"result_info":{"cursors":{"after":"c1"}}}`))
+		case "c1":
+			if query.Has("page") {
+				t.Fatalf("cursor requests must not send page: %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"b"}],"result_info":{"cursors":{"after":"c2"}}}`))
+		case "c2":
+			// A repeated cursor ends the walk instead of looping.
+			_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"c"}],"result_info":{"cursors":{"after":"c2"}}}`))
+		default:
+			t.Fatalf("unexpected cursor: %s", query.Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	items, err := listAllPages[Zone](context.Background(), client, "/accounts/acc-1/access/apps", nil, "app list")
+	if err != nil {
+		t.Fatalf("list all pages: %v", err)
+	}
+	if len(items) != 3 || items[2].ID != "c" || calls.Load() != 3 {
+		t.Fatalf("unexpected items %#v after %d calls", items, calls.Load())
+	}
+}
+
+func TestNextPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		info       *ResultInfo
+		page       int
+		cursor     string
+		wantCursor string
+		wantMore   bool
+	}{
+		{name: "no result info", page: 1},
+		{name: "more numbered pages", info: &ResultInfo{TotalPages: 3}, page: 2, wantMore: true},
+		{name: "last numbered page", info: &ResultInfo{TotalPages: 3}, page: 3},
+		{name: "next cursor", info: &ResultInfo{Cursors: &ResultCursors{After: "c1"}}, wantCursor: "c1", wantMore: true},
+		{name: "empty cursor", info: &ResultInfo{Cursors: &ResultCursors{}}},
+		{name: "repeated cursor", info: &ResultInfo{Cursors: &ResultCursors{After: "c1"}}, cursor: "c1", wantCursor: "c1"},
+	}
+	for _, tt := range tests {
+		cursor, more := nextPage(tt.info, tt.page, tt.cursor)
+		if cursor != tt.wantCursor || more != tt.wantMore {
+			t.Fatalf("%s: nextPage = %q, %v; want %q, %v", tt.name, cursor, more, tt.wantCursor, tt.wantMore)
+		}
+	}
+}
+
+func TestListAllPages_ParallelPagination(t *testing.T) {
+	t.Parallel()
+
+	const totalPages = 6
+	var inflight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inflight.Add(1)
+		defer inflight.Add(-1)
+		for {
+			prev := peak.Load()
+			if current <= prev || peak.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page > 1 {
+			// Later pages answer out of order.
+			time.Sleep(time.Duration(totalPages-page) * 5 * time.Millisecond)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": fmt.Sprintf("zone-%d", page)}},
+			"result_info": map[string]any{"page": page, "per_page": 1, "total_pages": totalPages},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithParallelPagination(3))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zones, err := client.ListZones(context.Background())
+	if err != nil {
+		t.Fatalf("list zones: %v", err)
+	}
+	if len(zones) != totalPages {
+		t.Fatalf("expected %d zones, got %d", totalPages, len(zones))
+	}
+	for i, zone := range zones {
+		if want := fmt.Sprintf("zone-%d", i+1); zone.ID != want {
+			t.Fatalf("zone %d: got %q want %q", i, zone.ID, want)
+		}
+	}
+	if got := peak.Load(); got < 2 || got > 3 {
+		t.Fatalf("expected 2-3 concurrent requests, got %d", got)
+	}
+
+	zones, err = client.ListZones(context.Background(), WithMaxPages(4))
+	if err != nil {
+		t.Fatalf("list zones with max pages: %v", err)
+	}
+	if len(zones) != 4 {
+		t.Fatalf("expected WithMaxPages to bound parallel fetches to 4 zones, got %d", len(zones))
+	}
+}
+
+func TestListAllPages_ParallelPaginationStopsOnError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 3 {
+			http.Error(w, `{"success":false,"errors":[{"code":1000,"message":"boom"}]}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success":     true,
+			"result":      []map[string]any{{"id": fmt.Sprintf("zone-%d", page)}},
+			"result_info": map[string]any{"page": page, "per_page": 1, "total_pages": 5},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithParallelPagination(4))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var statusErr *HTTPStatusError
+	if _, err := client.ListZones(context.Background()); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected 400 status error, got %v", err)
+	}
+}
+
+func TestListAllPages_ParallelPaginationBoundsBogusTotalPages(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		totalPages   int
+		wantRequests int32
+	}{
+		// Pages past 5 are empty, so the walk ends within the window holding
+		// page 6 instead of requesting every reported page.
+		{name: "windowed walk stops at empty page", totalPages: 20, wantRequests: 7},
+		// A total_pages above the ceiling is walked sequentially.
+		{name: "huge total pages walks sequentially", totalPages: 1_000_000_000, wantRequests: 6},
+	}
+
+	for _, tc := range tests {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			result := []map[string]any{}
+			if page <= 5 {
+				result = append(result, map[string]any{"id": fmt.Sprintf("zone-%d", page)})
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success":     true,
+				"result":      result,
+				"result_info": map[string]any{"page": page, "per_page": 1, "total_pages": tc.totalPages},
+			})
+		}))
+
+		client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second), WithParallelPagination(2))
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+		zones, err := client.ListZones(context.Background())
+		server.Close()
+		if err != nil {
+			t.Fatalf("%s: list zones: %v", tc.name, err)
+		}
+		if len(zones) != 5 {
+			t.Fatalf("%s: expected 5 zones, got %d", tc.name, len(zones))
+		}
+		if got := calls.Load(); got != tc.wantRequests {
+			t.Fatalf("%s: expected %d requests, got %d", tc.name, tc.wantRequests, got)
+		}
+	}
+}
+
+func TestPaginateItemsLeavesCallerOptionsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":[{"id":"zone-1"}],"result_info":{"page":1,"total_pages":1}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	// Spare capacity lets an unclipped append write into the caller's array.
+	reqOpts := make([]RequestOption, 1, 2)
+	reqOpts[0] = WithPerPage(50)
+	for _, err := range client.PaginateItems(context.Background(), "/zones", nil, reqOpts...) {
+		if err != nil {
+			t.Fatalf("paginate items: %v", err)
+		}
+	}
+	if spare := reqOpts[:2][1]; spare != nil {
+		t.Fatalf("PaginateItems wrote into the caller's option slice")
+	}
+}
@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRulesetsPayloadLoggingConfig(t *testing.T) {
+	t.Parallel()
+
+	publicKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	stored := ""
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/accounts/acc-1/rulesets/rs-1/payload" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var payload PayloadLoggingConfig
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode request body: %v", err)
+			}
+			stored = payload.PublicKey
+		case http.MethodGet:
+		default:
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": PayloadLoggingConfig{PublicKey: stored}})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Rulesets().SetPayloadLoggingConfig(context.Background(), "acc-1", "rs-1", publicKey); err != nil {
+		t.Fatalf("set payload logging config: %v", err)
+	}
+	config, err := client.Rulesets().GetPayloadLoggingConfig(context.Background(), "acc-1", "rs-1")
+	if err != nil {
+		t.Fatalf("get payload logging config: %v", err)
+	}
+	if config.PublicKey != publicKey {
+		t.Fatalf("unexpected config: %#v", config)
+	}
+}
+
+func TestRulesetsSetPayloadLoggingConfigValidatesKey(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	for name, key := range map[string]string{
+		"empty":      " ",
+		"not base64": "not-base64!",
+		"wrong size": base64.StdEncoding.EncodeToString(make([]byte, 16)),
+	} {
+		err := client.Rulesets().SetPayloadLoggingConfig(context.Background(), "acc-1", "rs-1", key)
+		if err == nil || !strings.Contains(err.Error(), "public key") {
+			t.Fatalf("%s: expected public key validation error, got: %v", name, err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package cloudflare
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -16,6 +17,20 @@ const (
 	ScopeZones ScopeKind = "zones"
 )
 
+type scopeContextKey struct{ kind ScopeKind }
+
+// ContextWithAccount returns a context carrying a default account ID. Service
+// methods given an empty account ID use it instead.
+func ContextWithAccount(ctx context.Context, accountID string) context.Context {
+	return context.WithValue(ctx, scopeContextKey{kind: ScopeAccounts}, strings.TrimSpace(accountID))
+}
+
+// ContextWithZone returns a context carrying a default zone ID. Service
+// methods given an empty zone ID use it instead.
+func ContextWithZone(ctx context.Context, zoneID string) context.Context {
+	return context.WithValue(ctx, scopeContextKey{kind: ScopeZones}, strings.TrimSpace(zoneID))
+}
+
 // Scope identifies whether an operation is account- or zone-scoped.
 type Scope struct {
 	Kind ScopeKind
@@ -53,3 +68,29 @@ func (s Scope) PathPrefix() (string, error) {
 
 	return fmt.Sprintf("%s/%s", s.Kind, url.PathEscape(s.ID)), nil
 }
+
+// scopePrefix returns the path prefix for scope, taking the ID from ctx when
+// the scope ID is empty.
+func scopePrefix(ctx context.Context, scope Scope) (string, error) {
+	resolved, err := resolveScope(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	return resolved.PathPrefix()
+}
+
+// resolveScope returns scope with its ID taken from ctx when empty, for
+// requests that also need the ID outside the path.
+func resolveScope(ctx context.Context, scope Scope) (Scope, error) {
+	if scope.ID == "" {
+		scope.ID, _ = ctx.Value(scopeContextKey{kind: scope.Kind}).(string)
+	}
+	if scope.ID == "" && (scope.Kind == ScopeAccounts || scope.Kind == ScopeZones) {
+		helper := "ContextWithAccount"
+		if scope.Kind == ScopeZones {
+			helper = "ContextWithZone"
+		}
+		return Scope{}, fmt.Errorf("scope ID must not be empty for %q: pass an ID or set a default with %s", scope.Kind, helper)
+	}
+	return scope, nil
+}
@@ -1,6 +1,14 @@
 package cloudflare
 
-import "testing"
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestScopePathPrefix(t *testing.T) {
 	t.Parallel()
@@ -33,3 +41,56 @@ func TestScopePathPrefixValidation(t *testing.T) {
 		t.Fatalf("expected unsupported scope kind validation error")
 	}
 }
+
+func TestScopePrefixUsesContextDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := ContextWithZone(ContextWithAccount(context.Background(), "acc-ctx"), "zone-ctx")
+
+	tests := []struct {
+		name  string
+		scope Scope
+		want  string
+	}{
+		{name: "account from context", scope: AccountScope(""), want: "accounts/acc-ctx"},
+		{name: "zone from context", scope: ZoneScope(" "), want: "zones/zone-ctx"},
+		{name: "explicit ID wins", scope: AccountScope("acc-1"), want: "accounts/acc-1"},
+	}
+	for _, tt := range tests {
+		got, err := scopePrefix(ctx, tt.scope)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+
+	_, err := scopePrefix(context.Background(), ZoneScope(""))
+	if err == nil || !strings.Contains(err.Error(), "ContextWithZone") {
+		t.Fatalf("expected missing zone error mentioning ContextWithZone, got: %v", err)
+	}
+}
+
+func TestServiceMethodsReadScopeFromContext(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone-ctx/dns_records/rec-1" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "rec-1"}})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := ContextWithZone(context.Background(), "zone-ctx")
+	if _, err := client.DNS().GetRecord(ctx, "", "rec-1"); err != nil {
+		t.Fatalf("get record: %v", err)
+	}
+}
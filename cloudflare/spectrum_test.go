@@ -0,0 +1,95 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSpectrumCreateApplication(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		if r.URL.Path != "/zones/zone-1/spectrum/apps" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		var payload SpectrumApp
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if payload.Protocol != "tcp/22" || payload.DNS.Name != "ssh.acme.com" || payload.ProxyProtocol != "v2" {
+			t.Fatalf("unexpected app payload: %#v", payload)
+		}
+
+		payload.ID = "app-1"
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": payload})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	var out SpectrumApp
+	err = client.Spectrum().CreateApplication(context.Background(), "zone-1", SpectrumApp{
+		Protocol:      "tcp/22",
+		DNS:           SpectrumDNS{Type: "CNAME", Name: "ssh.acme.com"},
+		OriginDirect:  []string{"tcp://192.0.2.1:22"},
+		IPFirewall:    true,
+		ProxyProtocol: "v2",
+	}, &out)
+	if err != nil {
+		t.Fatalf("create application: %v", err)
+	}
+	if out.ID != "app-1" {
+		t.Fatalf("unexpected response payload: %#v", out)
+	}
+}
+
+func TestSpectrumDeleteApplicationValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.Spectrum().DeleteApplication(context.Background(), "zone-1", " "); err == nil {
+		t.Fatalf("expected empty app ID validation error")
+	}
+	if err := client.Spectrum().DeleteApplication(context.Background(), "", "app-1"); err == nil {
+		t.Fatalf("expected empty zone ID validation error")
+	}
+}
+
+func TestSpectrumNilOut(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"success":true,"result":{"id":"app-1","protocol":"tcp/22"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	app := SpectrumApp{Protocol: "tcp/22"}
+
+	if err := client.Spectrum().CreateApplication(context.Background(), "zone-1", app, nil); err != nil {
+		t.Fatalf("create application: %v", err)
+	}
+	if err := client.Spectrum().UpdateApplication(context.Background(), "zone-1", "app-1", app, nil); err != nil {
+		t.Fatalf("update application: %v", err)
+	}
+}
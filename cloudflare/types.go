@@ -15,6 +15,28 @@ type ResultInfo struct {
 	TotalPages int `json:"total_pages"`
 	Count      int `json:"count"`
 	TotalCount int `json:"total_count"`
+
+	Cursors *ResultCursors `json:"cursors,omitempty"`
+}
+
+// ResultCursors contains cursor pagination metadata for endpoints that page
+// with a cursor query parameter instead of page numbers.
+type ResultCursors struct {
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// Response is the decoded envelope of a Cloudflare API response returned by
+// DoRaw, for callers that drive their own pagination loops.
+type Response struct {
+	// Result is the undecoded result field.
+	Result json.RawMessage
+	// ResultInfo is the pagination metadata, or nil when the endpoint does
+	// not return any.
+	ResultInfo *ResultInfo
+	// NextCursor is the after cursor for the next page of a cursor-paginated
+	// endpoint, empty on the last page or for page-numbered endpoints.
+	NextCursor string
 }
 
 type envelope struct {
@@ -26,6 +48,7 @@ type envelope struct {
 
 // Zone represents a Cloudflare DNS zone.
 type Zone struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status,omitempty"`
 }
@@ -0,0 +1,171 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrUnauthorized indicates Cloudflare rejected the credentials with a 401 or
+// 403 response.
+var ErrUnauthorized = errors.New("cloudflare credentials rejected")
+
+// TokenStatus describes the API token reported by /user/tokens/verify.
+type TokenStatus struct {
+	ID        string `json:"id"`
+	Status    string `json:"status"`
+	NotBefore string `json:"not_before,omitempty"`
+	ExpiresOn string `json:"expires_on,omitempty"`
+}
+
+// PermissionGroup is a named set of permissions granted by an API token
+// policy, such as "DNS Write".
+type PermissionGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type tokenDetails struct {
+	Policies []struct {
+		Effect           string            `json:"effect"`
+		PermissionGroups []PermissionGroup `json:"permission_groups"`
+	} `json:"policies"`
+}
+
+// User represents the Cloudflare user that owns the authenticated token.
+type User struct {
+	ID                             string `json:"id"`
+	Email                          string `json:"email"`
+	FirstName                      string `json:"first_name"`
+	LastName                       string `json:"last_name"`
+	TwoFactorAuthenticationEnabled bool   `json:"two_factor_authentication_enabled"`
+	Suspended                      bool   `json:"suspended"`
+}
+
+// Membership represents the user's membership in a Cloudflare account.
+type Membership struct {
+	ID          string
+	Status      string
+	AccountID   string
+	AccountName string
+	Roles       []string
+}
+
+// membershipWire is a /memberships item as returned, with the account nested.
+type membershipWire struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Account struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"account"`
+	Roles []string `json:"roles"`
+}
+
+// GetUser returns the user that owns the authenticated token.
+func (c *Client) GetUser(ctx context.Context) (*User, error) {
+	var user User
+	if err := c.Do(ctx, http.MethodGet, "/user", nil, nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// ListAccountMemberships lists the accounts and roles of the token's user.
+func (c *Client) ListAccountMemberships(ctx context.Context, reqOpts ...RequestOption) ([]Membership, error) {
+	items, err := listAllPages[membershipWire](ctx, c, "/memberships", nil, "membership list", reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]Membership, 0, len(items))
+	for _, item := range items {
+		memberships = append(memberships, Membership{
+			ID:          item.ID,
+			Status:      item.Status,
+			AccountID:   item.Account.ID,
+			AccountName: item.Account.Name,
+			Roles:       item.Roles,
+		})
+	}
+	return memberships, nil
+}
+
+// VerifyToken checks the client's API token. A rejected token is reported as
+// ErrUnauthorized wrapping the *HTTPStatusError; an accepted but inactive token
+// is returned with its Status (for example "disabled" or "expired").
+func (c *Client) VerifyToken(ctx context.Context, reqOpts ...RequestOption) (*TokenStatus, error) {
+	var status TokenStatus
+	err := c.DoWithOptions(ctx, http.MethodGet, "/user/tokens/verify", nil, nil, &status, reqOpts...)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) &&
+		(statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden) {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthorized, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+// TokenPermissions returns the permission groups granted by the client's API
+// token, from policies with an "allow" effect, without duplicates. Reading the
+// token details needs the token to hold the "API Tokens Read" permission.
+func (c *Client) TokenPermissions(ctx context.Context, reqOpts ...RequestOption) ([]PermissionGroup, error) {
+	status, err := c.VerifyToken(ctx, reqOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var details tokenDetails
+	endpoint := "/user/tokens/" + url.PathEscape(status.ID)
+	err = c.DoWithOptions(ctx, http.MethodGet, endpoint, nil, nil, &details, reqOpts...)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) &&
+		(statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden) {
+		return nil, fmt.Errorf("%w: read token details: %w", ErrUnauthorized, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	groups := []PermissionGroup{}
+	for _, policy := range details.Policies {
+		if policy.Effect != "allow" {
+			continue
+		}
+		for _, group := range policy.PermissionGroups {
+			if seen[group.ID] {
+				continue
+			}
+			seen[group.ID] = true
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// HasPermission reports whether the client's API token grants the named
+// permission group, compared case-insensitively, so tooling can fail early
+// with a clear message instead of a 403 partway through an operation.
+func (c *Client) HasPermission(ctx context.Context, permissionName string, reqOpts ...RequestOption) (bool, error) {
+	cleanName := strings.TrimSpace(permissionName)
+	if cleanName == "" {
+		return false, errors.New("permission name must not be empty")
+	}
+
+	groups, err := c.TokenPermissions(ctx, reqOpts...)
+	if err != nil {
+		return false, err
+	}
+	for _, group := range groups {
+		if strings.EqualFold(group.Name, cleanName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,224 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": map[string]any{
+				"id":    "user-1",
+				"email": "ops@acme.com",
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	user, err := client.GetUser(context.Background())
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	if user.ID != "user-1" || user.Email != "ops@acme.com" {
+		t.Fatalf("unexpected user: %#v", user)
+	}
+}
+
+func TestListAccountMemberships_Paginates(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/memberships" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		page := r.URL.Query().Get("page")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": []map[string]any{
+				{
+					"id":      "mem-" + page,
+					"status":  "accepted",
+					"account": map[string]any{"id": "acc-" + page, "name": "Account " + page},
+					"roles":   []string{"Administrator"},
+				},
+			},
+			"result_info": map[string]any{"total_pages": 2},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	memberships, err := client.ListAccountMemberships(context.Background())
+	if err != nil {
+		t.Fatalf("list memberships: %v", err)
+	}
+	if len(memberships) != 2 {
+		t.Fatalf("expected 2 memberships, got: %d", len(memberships))
+	}
+	if memberships[1].AccountID != "acc-2" || memberships[1].AccountName != "Account 2" {
+		t.Fatalf("unexpected membership: %#v", memberships[1])
+	}
+	if len(memberships[0].Roles) != 1 || memberships[0].Roles[0] != "Administrator" {
+		t.Fatalf("unexpected roles: %#v", memberships[0].Roles)
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		wantStatus string
+		wantErr    error
+	}{
+		{name: "active token", statusCode: http.StatusOK, wantStatus: "active"},
+		{name: "invalid token", statusCode: http.StatusUnauthorized, wantErr: ErrUnauthorized},
+		{name: "missing permission", statusCode: http.StatusForbidden, wantErr: ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/user/tokens/verify" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				if tt.statusCode != http.StatusOK {
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"success": false,
+						"errors":  []map[string]any{{"code": 1000, "message": "Invalid API Token"}},
+					})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"success": true,
+					"result":  map[string]any{"id": "tok-1", "status": "active"},
+				})
+			}))
+			defer server.Close()
+
+			client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			status, err := client.VerifyToken(context.Background())
+			if tt.wantErr != nil {
+				var statusErr *HTTPStatusError
+				if !errors.Is(err, tt.wantErr) || !errors.As(err, &statusErr) || statusErr.StatusCode != tt.statusCode {
+					t.Fatalf("expected %v wrapping HTTP %d, got %v", tt.wantErr, tt.statusCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("verify token: %v", err)
+			}
+			if status.ID != "tok-1" || status.Status != tt.wantStatus {
+				t.Fatalf("unexpected token status: %#v", status)
+			}
+		})
+	}
+}
+
+func TestTokenPermissions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/user/tokens/verify":
+			_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": map[string]any{"id": "tok-1", "status": "active"}})
+		case "/user/tokens/tok-1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"success": true,
+				"result": map[string]any{
+					"id": "tok-1",
+					"policies": []map[string]any{
+						{
+							"effect": "allow",
+							"permission_groups": []map[string]any{
+								{"id": "pg-dns-write", "name": "DNS Write"},
+								{"id": "pg-zone-read", "name": "Zone Read"},
+							},
+						},
+						{
+							"effect":            "allow",
+							"permission_groups": []map[string]any{{"id": "pg-dns-write", "name": "DNS Write"}},
+						},
+						{
+							"effect":            "deny",
+							"permission_groups": []map[string]any{{"id": "pg-ssl", "name": "SSL and Certificates Write"}},
+						},
+					},
+				},
+			})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	groups, err := client.TokenPermissions(ctx)
+	if err != nil {
+		t.Fatalf("token permissions: %v", err)
+	}
+	if len(groups) != 2 || groups[0].Name != "DNS Write" || groups[1].Name != "Zone Read" {
+		t.Fatalf("unexpected permission groups: %#v", groups)
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{name: "dns write", want: true},
+		{name: "Zone Read", want: true},
+		{name: "SSL and Certificates Write", want: false},
+	}
+	for _, tt := range tests {
+		got, err := client.HasPermission(ctx, tt.name)
+		if err != nil {
+			t.Fatalf("has permission %q: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("HasPermission(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := client.HasPermission(ctx, " "); err == nil {
+		t.Fatalf("expected empty permission name validation error")
+	}
+}
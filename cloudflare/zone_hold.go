@@ -0,0 +1,87 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ZoneHold reports whether a zone is held, which stops the zone, and
+// optionally its subdomains, from being added to another account.
+type ZoneHold struct {
+	Hold              bool   `json:"hold"`
+	HoldAfter         string `json:"hold_after,omitempty"`
+	IncludeSubdomains bool   `json:"include_subdomains"`
+}
+
+// zoneHoldWire is a hold result as returned. The API has sent
+// include_subdomains as either a boolean or a string.
+type zoneHoldWire struct {
+	Hold              bool            `json:"hold"`
+	HoldAfter         string          `json:"hold_after"`
+	IncludeSubdomains json.RawMessage `json:"include_subdomains"`
+}
+
+// zoneHold converts a decoded hold result, parsing include_subdomains with c's
+// JSON codec.
+func (w zoneHoldWire) zoneHold(c *Client) (*ZoneHold, error) {
+	hold := &ZoneHold{Hold: w.Hold, HoldAfter: w.HoldAfter}
+	if len(w.IncludeSubdomains) == 0 || string(w.IncludeSubdomains) == "null" {
+		return hold, nil
+	}
+	if err := c.unmarshalJSON(w.IncludeSubdomains, &hold.IncludeSubdomains); err == nil {
+		return hold, nil
+	}
+	var text string
+	if err := c.unmarshalJSON(w.IncludeSubdomains, &text); err != nil {
+		return nil, fmt.Errorf("decode include_subdomains: %w", err)
+	}
+	hold.IncludeSubdomains, _ = strconv.ParseBool(text)
+	return hold, nil
+}
+
+// GetZoneHold returns the hold status of a zone.
+func (c *Client) GetZoneHold(ctx context.Context, zoneID string, reqOpts ...RequestOption) (*ZoneHold, error) {
+	return c.zoneHold(ctx, zoneID, http.MethodGet, nil, reqOpts...)
+}
+
+// CreateZoneHold places a hold on a zone, extending it to subdomains when
+// includeSubdomains is true.
+func (c *Client) CreateZoneHold(
+	ctx context.Context,
+	zoneID string,
+	includeSubdomains bool,
+	reqOpts ...RequestOption,
+) (*ZoneHold, error) {
+	params := url.Values{}
+	params.Set("include_subdomains", strconv.FormatBool(includeSubdomains))
+	return c.zoneHold(ctx, zoneID, http.MethodPost, params, reqOpts...)
+}
+
+// RemoveZoneHold removes the hold on a zone.
+func (c *Client) RemoveZoneHold(ctx context.Context, zoneID string, reqOpts ...RequestOption) (*ZoneHold, error) {
+	return c.zoneHold(ctx, zoneID, http.MethodDelete, nil, reqOpts...)
+}
+
+func (c *Client) zoneHold(
+	ctx context.Context,
+	zoneID string,
+	method string,
+	params url.Values,
+	reqOpts ...RequestOption,
+) (*ZoneHold, error) {
+	prefix, err := scopePrefix(ctx, ZoneScope(zoneID))
+	if err != nil {
+		return nil, err
+	}
+
+	var hold zoneHoldWire
+	endpoint := fmt.Sprintf("/%s/hold", prefix)
+	if err := c.DoWithOptions(ctx, method, endpoint, params, nil, &hold, reqOpts...); err != nil {
+		return nil, err
+	}
+	return hold.zoneHold(c)
+}
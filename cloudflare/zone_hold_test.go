@@ -0,0 +1,72 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestZoneHold(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/zones/zone-1/hold" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+
+		var result map[string]any
+		switch r.Method {
+		case http.MethodGet:
+			result = map[string]any{"hold": true, "hold_after": "2026-01-31T15:56:36Z", "include_subdomains": "true"}
+		case http.MethodPost:
+			if got := r.URL.Query().Get("include_subdomains"); got != "true" {
+				t.Fatalf("unexpected include_subdomains: %q", got)
+			}
+			result = map[string]any{"hold": true, "include_subdomains": true}
+		case http.MethodDelete:
+			result = map[string]any{"hold": false, "include_subdomains": false}
+		default:
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"success": true, "result": result})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	hold, err := client.GetZoneHold(ctx, "zone-1")
+	if err != nil {
+		t.Fatalf("get zone hold: %v", err)
+	}
+	if !hold.Hold || !hold.IncludeSubdomains || hold.HoldAfter != "2026-01-31T15:56:36Z" {
+		t.Fatalf("unexpected hold: %#v", hold)
+	}
+
+	hold, err = client.CreateZoneHold(ctx, "zone-1", true)
+	if err != nil {
+		t.Fatalf("create zone hold: %v", err)
+	}
+	if !hold.Hold || !hold.IncludeSubdomains {
+		t.Fatalf("unexpected hold: %#v", hold)
+	}
+
+	hold, err = client.RemoveZoneHold(ctx, "zone-1")
+	if err != nil {
+		t.Fatalf("remove zone hold: %v", err)
+	}
+	if hold.Hold {
+		t.Fatalf("expected hold to be removed: %#v", hold)
+	}
+
+	if _, err := client.GetZoneHold(ctx, ""); err == nil {
+		t.Fatalf("expected empty zone ID validation error")
+	}
+}
@@ -0,0 +1,58 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CreateZone adds a full-setup zone named name to an account and returns it
+// with its new ID. With jumpStart, Cloudflare scans for existing DNS records
+// to import. An empty accountID falls back to the account set with
+// ContextWithAccount.
+func (c *Client) CreateZone(
+	ctx context.Context,
+	accountID string,
+	name string,
+	jumpStart bool,
+	reqOpts ...RequestOption,
+) (*Zone, error) {
+	account, err := resolveScope(ctx, AccountScope(accountID))
+	if err != nil {
+		return nil, err
+	}
+	cleanName := strings.TrimSpace(name)
+	if cleanName == "" {
+		return nil, errors.New("zone name must not be empty")
+	}
+
+	body := map[string]any{
+		"account":    map[string]string{"id": account.ID},
+		"name":       cleanName,
+		"jump_start": jumpStart,
+		"type":       "full",
+	}
+	var zone Zone
+	if err := c.DoWithOptions(ctx, http.MethodPost, "/zones", nil, body, &zone, reqOpts...); err != nil {
+		return nil, err
+	}
+	return &zone, nil
+}
+
+// DeleteZone deletes a zone. A zone that does not exist is reported as
+// ErrZoneNotFound wrapping the *HTTPStatusError.
+func (c *Client) DeleteZone(ctx context.Context, zoneID string, reqOpts ...RequestOption) error {
+	prefix, err := scopePrefix(ctx, ZoneScope(zoneID))
+	if err != nil {
+		return err
+	}
+
+	err = c.DoWithOptions(ctx, http.MethodDelete, "/"+prefix, nil, nil, nil, reqOpts...)
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s: %w", ErrZoneNotFound, prefix, err)
+	}
+	return err
+}
@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateZone(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/zones" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		account, _ := body["account"].(map[string]any)
+		if account["id"] != "acc-1" || body["name"] != "example.com" || body["jump_start"] != true || body["type"] != "full" {
+			t.Fatalf("unexpected body: %#v", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"success": true,
+			"result": map[string]any{
+				"id":           "zone-1",
+				"name":         "example.com",
+				"status":       "pending",
+				"name_servers": []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	zone, err := client.CreateZone(context.Background(), "acc-1", " example.com ", true)
+	if err != nil {
+		t.Fatalf("create zone: %v", err)
+	}
+	if zone.ID != "zone-1" || zone.Status != "pending" {
+		t.Fatalf("unexpected zone: %#v", zone)
+	}
+
+	if _, err := client.CreateZone(ContextWithAccount(context.Background(), "acc-1"), "", "example.com", true); err != nil {
+		t.Fatalf("create zone with context account: %v", err)
+	}
+	if _, err := client.CreateZone(context.Background(), "", "example.com", false); err == nil {
+		t.Fatalf("expected account id validation error")
+	}
+	if _, err := client.CreateZone(context.Background(), "acc-1", " ", false); err == nil {
+		t.Fatalf("expected zone name validation error")
+	}
+}
+
+func TestDeleteZone(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Fatalf("unexpected method: %s", r.Method)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/zones/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":1001,"message":"Invalid zone identifier"}]}`))
+			return
+		}
+		if r.URL.Path != "/zones/zone-1" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"success":true,"result":{"id":"zone-1"}}`))
+	}))
+	defer server.Close()
+
+	client, err := New("token", WithBaseURL(server.URL), WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.DeleteZone(context.Background(), "zone-1"); err != nil {
+		t.Fatalf("delete zone: %v", err)
+	}
+
+	err = client.DeleteZone(context.Background(), "missing")
+	var statusErr *HTTPStatusError
+	if !errors.Is(err, ErrZoneNotFound) || !errors.As(err, &statusErr) {
+		t.Fatalf("expected ErrZoneNotFound wrapping a status error, got %v", err)
+	}
+}
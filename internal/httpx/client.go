@@ -1,13 +1,19 @@
 package httpx
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"time"
 )
 
-// DefaultTimeout defines the default request timeout used by helper clients.
-const DefaultTimeout = 30 * time.Second
+const (
+	// DefaultTimeout defines the default request timeout used by helper clients.
+	DefaultTimeout = 30 * time.Second
+	// DefaultMaxResponseHeaderBytes caps response header size for helper
+	// clients, well below net/http's 10 MiB transport default.
+	DefaultMaxResponseHeaderBytes int64 = 1 << 20
+)
 
 // NewClient returns an HTTP client with sensible pooling defaults.
 func NewClient(timeout time.Duration) *http.Client {
@@ -21,16 +27,74 @@ func NewClient(timeout time.Duration) *http.Client {
 			Timeout:   10 * time.Second,
 			KeepAlive: 30 * time.Second,
 		}).DialContext,
-		ForceAttemptHTTP2:     true,
-		MaxIdleConns:          100,
-		MaxIdleConnsPerHost:   20,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   10 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:      true,
+		MaxIdleConns:           100,
+		MaxIdleConnsPerHost:    20,
+		IdleConnTimeout:        90 * time.Second,
+		TLSHandshakeTimeout:    10 * time.Second,
+		ExpectContinueTimeout:  1 * time.Second,
+		MaxResponseHeaderBytes: DefaultMaxResponseHeaderBytes,
 	}
 
 	return &http.Client{
-		Timeout:   timeout,
-		Transport: transport,
+		Timeout:       timeout,
+		Transport:     transport,
+		CheckRedirect: SameHostRedirectPolicy,
+	}
+}
+
+// CopyClient returns a shallow copy of client so timeouts, redirect policy,
+// and transport settings can be applied without changing a client the caller
+// may share with other code. Transport changes made through this package
+// clone the transport first, so the copy still shares the original
+// connection pool until then.
+func CopyClient(client *http.Client) *http.Client {
+	clone := *client
+	return &clone
+}
+
+// CloneTransport returns a copy of client's transport so it can be customized
+// without affecting other clients. A nil transport clones http.DefaultTransport.
+func CloneTransport(client *http.Client) (*http.Transport, error) {
+	switch rt := client.Transport.(type) {
+	case nil:
+		return http.DefaultTransport.(*http.Transport).Clone(), nil
+	case *http.Transport:
+		return rt.Clone(), nil
+	default:
+		return nil, fmt.Errorf("transport customization requires *http.Transport, got %T", rt)
+	}
+}
+
+// ApplyResponseHeaderTimeout bounds the time to wait for response headers
+// after a request is written, independent of the overall client timeout.
+// A non-positive timeout leaves client unchanged.
+func ApplyResponseHeaderTimeout(client *http.Client, timeout time.Duration) error {
+	if timeout <= 0 {
+		return nil
+	}
+
+	transport, err := CloneTransport(client)
+	if err != nil {
+		return err
+	}
+	transport.ResponseHeaderTimeout = timeout
+	client.Transport = transport
+	return nil
+}
+
+// ApplyMaxResponseHeaderBytes caps the size of response headers the client
+// accepts. A non-positive limit leaves client unchanged.
+func ApplyMaxResponseHeaderBytes(client *http.Client, limit int64) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	transport, err := CloneTransport(client)
+	if err != nil {
+		return err
 	}
+	transport.MaxResponseHeaderBytes = limit
+	client.Transport = transport
+	return nil
 }
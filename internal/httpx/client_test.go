@@ -0,0 +1,69 @@
+package httpx
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestApplyResponseHeaderTimeout(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(time.Minute)
+	original := client.Transport.(*http.Transport)
+
+	if err := ApplyResponseHeaderTimeout(client, 2*time.Second); err != nil {
+		t.Fatalf("apply response header timeout: %v", err)
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport type: %T", client.Transport)
+	}
+	if transport.ResponseHeaderTimeout != 2*time.Second {
+		t.Fatalf("unexpected response header timeout: %s", transport.ResponseHeaderTimeout)
+	}
+	if original.ResponseHeaderTimeout != 0 {
+		t.Fatalf("expected original transport to be left unchanged")
+	}
+}
+
+func TestApplyResponseHeaderTimeout_RejectsCustomRoundTripper(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})}
+
+	if err := ApplyResponseHeaderTimeout(client, time.Second); err == nil {
+		t.Fatalf("expected error for non-*http.Transport round tripper")
+	}
+}
+
+func TestApplyMaxResponseHeaderBytes(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(time.Minute)
+	original := client.Transport.(*http.Transport)
+	if original.MaxResponseHeaderBytes != DefaultMaxResponseHeaderBytes {
+		t.Fatalf("unexpected default max response header bytes: %d", original.MaxResponseHeaderBytes)
+	}
+
+	if err := ApplyMaxResponseHeaderBytes(client, 4096); err != nil {
+		t.Fatalf("apply max response header bytes: %v", err)
+	}
+
+	transport := client.Transport.(*http.Transport)
+	if transport.MaxResponseHeaderBytes != 4096 {
+		t.Fatalf("unexpected max response header bytes: %d", transport.MaxResponseHeaderBytes)
+	}
+	if original.MaxResponseHeaderBytes != DefaultMaxResponseHeaderBytes {
+		t.Fatalf("expected original transport to be left unchanged")
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,85 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// LogAttempt emits a debug record for one request attempt. Only the URL path
+// is logged so query strings, headers, and bodies never reach the log. A nil
+// logger is a no-op. Attempts are numbered from 1.
+func LogAttempt(
+	ctx context.Context,
+	logger *slog.Logger,
+	method string,
+	rawURL string,
+	attempt int,
+	status int,
+	elapsed time.Duration,
+	err error,
+) {
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("path", urlPath(rawURL)),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("duration", elapsed),
+	}
+	if status != 0 {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", redactedError(err)))
+	}
+	logger.LogAttrs(ctx, slog.LevelDebug, "http request attempt", attrs...)
+}
+
+// LogRetry emits a debug record when a retry of attempt is scheduled after
+// delay. reason says why, such as "status:503" or "transport:timeout", and is
+// omitted when empty.
+func LogRetry(
+	ctx context.Context,
+	logger *slog.Logger,
+	method string,
+	rawURL string,
+	attempt int,
+	delay time.Duration,
+	reason string,
+) {
+	if logger == nil || !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("path", urlPath(rawURL)),
+		slog.Int("attempt", attempt+1),
+		slog.Duration("delay", delay),
+	}
+	if reason != "" {
+		attrs = append(attrs, slog.String("reason", reason))
+	}
+	logger.LogAttrs(ctx, slog.LevelDebug, "http request retry scheduled", attrs...)
+}
+
+func urlPath(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.EscapedPath()
+}
+
+// redactedError drops the *url.Error wrapper, whose message repeats the full
+// request URL including its query string.
+func redactedError(err error) string {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err.Error()
+	}
+	return err.Error()
+}
@@ -0,0 +1,41 @@
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogAttemptRedactsURL(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	rawURL := "https://api.example.com/v1/items?token=secret"
+	err := &url.Error{Op: "Get", URL: rawURL, Err: errors.New("connection refused")}
+
+	LogAttempt(context.Background(), logger, "GET", rawURL, 0, 0, time.Millisecond, err)
+	LogRetry(context.Background(), logger, "GET", rawURL, 0, 2*time.Second, "transport:connection_refused")
+
+	out := buf.String()
+	if strings.Contains(out, "secret") {
+		t.Fatalf("log leaked query string: %s", out)
+	}
+	for _, want := range []string{"path=/v1/items", "attempt=1", `error="connection refused"`, "delay=2s", "reason=transport:connection_refused"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in log output: %s", want, out)
+		}
+	}
+}
+
+func TestLogAttemptNilLogger(t *testing.T) {
+	t.Parallel()
+
+	LogAttempt(context.Background(), nil, "GET", "https://example.com", 0, 200, 0, nil)
+	LogRetry(context.Background(), nil, "GET", "https://example.com", 0, 0, "")
+}
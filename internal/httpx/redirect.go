@@ -0,0 +1,40 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const maxRedirects = 10
+
+// SameHostRedirectPolicy follows redirects only to the original scheme and host,
+// so credentials in request headers are never forwarded to another origin.
+func SameHostRedirectPolicy(req *http.Request, via []*http.Request) error {
+	if len(via) == 0 {
+		return nil
+	}
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	origin := via[0].URL
+	if req.URL.Host != origin.Host {
+		return fmt.Errorf("refusing redirect to different host %q", req.URL.Host)
+	}
+	if req.URL.Scheme != origin.Scheme {
+		return errors.New("refusing redirect that changes URL scheme")
+	}
+	return nil
+}
+
+// ApplyRedirectPolicy sets policy on client, or the same-host default when
+// policy is nil and client has none configured.
+func ApplyRedirectPolicy(client *http.Client, policy func(*http.Request, []*http.Request) error) {
+	switch {
+	case policy != nil:
+		client.CheckRedirect = policy
+	case client.CheckRedirect == nil:
+		client.CheckRedirect = SameHostRedirectPolicy
+	}
+}
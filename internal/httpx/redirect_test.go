@@ -0,0 +1,31 @@
+package httpx
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSameHostRedirectPolicy(t *testing.T) {
+	t.Parallel()
+
+	origin, err := http.NewRequest(http.MethodGet, "https://vault.example.com/v1/secret/data/app", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	via := []*http.Request{origin}
+
+	sameHost, _ := http.NewRequest(http.MethodGet, "https://vault.example.com/v1/secret/data/other", nil)
+	if err := SameHostRedirectPolicy(sameHost, via); err != nil {
+		t.Fatalf("expected same-host redirect to be allowed: %v", err)
+	}
+
+	otherHost, _ := http.NewRequest(http.MethodGet, "https://attacker.example.net/", nil)
+	if err := SameHostRedirectPolicy(otherHost, via); err == nil {
+		t.Fatalf("expected cross-host redirect to be refused")
+	}
+
+	downgrade, _ := http.NewRequest(http.MethodGet, "http://vault.example.com/v1/secret/data/app", nil)
+	if err := SameHostRedirectPolicy(downgrade, via); err == nil {
+		t.Fatalf("expected scheme-changing redirect to be refused")
+	}
+}
@@ -127,6 +127,12 @@ func Retry(
 			return nil
 		}
 
+		// A canceled or expired context always ends the loop, even if
+		// shouldRetry would accept the error.
+		if ctx.Err() != nil {
+			return err
+		}
+
 		if !shouldRetry(err) || attempt >= config.MaxRetries {
 			return err
 		}
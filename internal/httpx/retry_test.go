@@ -100,3 +100,33 @@ func TestRetry_StopsOnNonRetryableError(t *testing.T) {
 		t.Fatalf("expected single attempt, got: %d", attempts)
 	}
 }
+
+func TestRetry_StopsWhenContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := Retry(
+		ctx,
+		RetryConfig{
+			MaxRetries: 5,
+			BaseDelay:  time.Second,
+			MaxDelay:   10 * time.Second,
+			Sleep:      func(context.Context, time.Duration) error { return nil },
+		},
+		func(error) bool { return true },
+		func(context.Context) error {
+			attempts++
+			return errTransient
+		},
+	)
+
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected operation error, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected single attempt with canceled context, got: %d", attempts)
+	}
+}
@@ -0,0 +1,81 @@
+package httpx
+
+import "sync/atomic"
+
+// ClientStats is a point-in-time snapshot of a client's request counters.
+type ClientStats struct {
+	// Requests counts logical requests, each of which may make several attempts.
+	Requests uint64
+	// Retries counts attempts made after the first for a request.
+	Retries uint64
+	// Failures counts requests that, after any retries, did not get a 2xx
+	// response: transport errors, cancellation, and final non-2xx statuses.
+	// Errors raised while decoding a 2xx response are not failures.
+	Failures uint64
+	// Status1xx through Status5xx count responses received, per attempt, by
+	// status class.
+	Status1xx uint64
+	Status2xx uint64
+	Status3xx uint64
+	Status4xx uint64
+	Status5xx uint64
+}
+
+// Stats holds request counters that are safe for concurrent use. The zero
+// value is ready to use.
+type Stats struct {
+	requests atomic.Uint64
+	retries  atomic.Uint64
+	failures atomic.Uint64
+	classes  [5]atomic.Uint64
+}
+
+// RecordRequest counts a logical request.
+func (s *Stats) RecordRequest() {
+	s.requests.Add(1)
+}
+
+// RecordRetry counts a retry attempt.
+func (s *Stats) RecordRetry() {
+	s.retries.Add(1)
+}
+
+// RecordFailure counts a request that ended without a 2xx response.
+func (s *Stats) RecordFailure() {
+	s.failures.Add(1)
+}
+
+// RecordStatus counts a response by status class. Codes outside 100-599 are
+// ignored.
+func (s *Stats) RecordStatus(statusCode int) {
+	class := statusCode/100 - 1
+	if class < 0 || class >= len(s.classes) {
+		return
+	}
+	s.classes[class].Add(1)
+}
+
+// Snapshot returns the current counter values. Counters are read
+// individually, so a snapshot taken under load may mix adjacent updates.
+func (s *Stats) Snapshot() ClientStats {
+	return ClientStats{
+		Requests:  s.requests.Load(),
+		Retries:   s.retries.Load(),
+		Failures:  s.failures.Load(),
+		Status1xx: s.classes[0].Load(),
+		Status2xx: s.classes[1].Load(),
+		Status3xx: s.classes[2].Load(),
+		Status4xx: s.classes[3].Load(),
+		Status5xx: s.classes[4].Load(),
+	}
+}
+
+// Reset sets every counter back to zero.
+func (s *Stats) Reset() {
+	s.requests.Store(0)
+	s.retries.Store(0)
+	s.failures.Store(0)
+	for i := range s.classes {
+		s.classes[i].Store(0)
+	}
+}
@@ -0,0 +1,37 @@
+package httpx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStatsConcurrentUpdates(t *testing.T) {
+	t.Parallel()
+
+	var stats Stats
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats.RecordRequest()
+			stats.RecordRetry()
+			stats.RecordStatus(503)
+			stats.RecordStatus(200)
+			stats.RecordStatus(999)
+		}()
+	}
+	wg.Wait()
+	stats.RecordFailure()
+
+	got := stats.Snapshot()
+	want := ClientStats{Requests: 50, Retries: 50, Failures: 1, Status2xx: 50, Status5xx: 50}
+	if got != want {
+		t.Fatalf("unexpected stats: %+v", got)
+	}
+
+	stats.Reset()
+	if got := stats.Snapshot(); got != (ClientStats{}) {
+		t.Fatalf("expected zero stats after reset, got %+v", got)
+	}
+}
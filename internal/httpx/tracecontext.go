@@ -0,0 +1,38 @@
+package httpx
+
+import (
+	"net/http"
+
+	"github.com/d-padmanabhan/platform-core-go/tracecontext"
+)
+
+type traceContextTransport struct {
+	base http.RoundTripper
+}
+
+// NewTraceContextTransport wraps base so each request carries the W3C
+// traceparent and tracestate headers of the span context in its context.
+// Requests without a span context are sent unchanged.
+func NewTraceContextTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &traceContextTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *traceContextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sc, ok := tracecontext.FromContext(req.Context())
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	clone := req.Clone(req.Context())
+	clone.Header.Set(tracecontext.HeaderTraceParent, sc.TraceParent)
+	if sc.TraceState != "" {
+		clone.Header.Set(tracecontext.HeaderTraceState, sc.TraceState)
+	} else {
+		clone.Header.Del(tracecontext.HeaderTraceState)
+	}
+	return t.base.RoundTrip(clone)
+}
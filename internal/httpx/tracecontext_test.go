@@ -0,0 +1,47 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/d-padmanabhan/platform-core-go/tracecontext"
+)
+
+func TestTraceContextTransport(t *testing.T) {
+	t.Parallel()
+
+	var got http.Header
+	transport := NewTraceContextTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		got = req.Header
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+
+	ctx, err := tracecontext.NewContext(context.Background(), tracecontext.SpanContext{
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		TraceState:  "vendor=value",
+	})
+	if err != nil {
+		t.Fatalf("new trace context: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.example.com/", nil)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if got.Get("traceparent") != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Fatalf("unexpected traceparent header: %q", got.Get("traceparent"))
+	}
+	if got.Get("tracestate") != "vendor=value" {
+		t.Fatalf("unexpected tracestate header: %q", got.Get("tracestate"))
+	}
+	if req.Header.Get("traceparent") != "" {
+		t.Fatalf("expected original request headers to be left unchanged")
+	}
+}
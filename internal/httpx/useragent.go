@@ -0,0 +1,31 @@
+package httpx
+
+import (
+	"runtime/debug"
+	"sync"
+)
+
+const modulePath = "github.com/d-padmanabhan/platform-core-go"
+
+// DefaultUserAgent returns the User-Agent sent by this module's clients,
+// "platform-core-go/<version>", using the module version recorded in the
+// binary's build info or "devel" when it is unavailable.
+var DefaultUserAgent = sync.OnceValue(func() string {
+	return "platform-core-go/" + moduleVersion()
+})
+
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	if info.Main.Path == modulePath && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == modulePath && dep.Version != "" {
+			return dep.Version
+		}
+	}
+	return "devel"
+}
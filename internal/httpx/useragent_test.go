@@ -0,0 +1,15 @@
+package httpx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultUserAgent(t *testing.T) {
+	t.Parallel()
+
+	ua := DefaultUserAgent()
+	if !strings.HasPrefix(ua, "platform-core-go/") || ua == "platform-core-go/" {
+		t.Fatalf("unexpected default user agent: %q", ua)
+	}
+}
@@ -0,0 +1,2 @@
+// Package tracecontext carries W3C Trace Context headers through a context.
+package tracecontext
@@ -0,0 +1,69 @@
+package tracecontext
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+const (
+	// HeaderTraceParent is the W3C traceparent header name.
+	HeaderTraceParent = "traceparent"
+	// HeaderTraceState is the W3C tracestate header name.
+	HeaderTraceState = "tracestate"
+)
+
+// ErrInvalidTraceParent indicates a traceparent value is not in W3C format.
+var ErrInvalidTraceParent = errors.New("invalid traceparent")
+
+// SpanContext holds the W3C trace context of the active span.
+type SpanContext struct {
+	TraceParent string
+	TraceState  string
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying sc after validating its traceparent.
+func NewContext(ctx context.Context, sc SpanContext) (context.Context, error) {
+	sc.TraceParent = strings.ToLower(strings.TrimSpace(sc.TraceParent))
+	sc.TraceState = strings.TrimSpace(sc.TraceState)
+	if err := ValidateTraceParent(sc.TraceParent); err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, contextKey{}, sc), nil
+}
+
+// FromContext returns the span context stored in ctx, if any.
+func FromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(contextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// ValidateTraceParent checks value against the W3C version-00 traceparent
+// format: 00-<32 hex trace-id>-<16 hex parent-id>-<2 hex flags>.
+func ValidateTraceParent(value string) error {
+	parts := strings.Split(value, "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return ErrInvalidTraceParent
+	}
+	if !isHex(parts[1], 32) || !isHex(parts[2], 16) || !isHex(parts[3], 2) {
+		return ErrInvalidTraceParent
+	}
+	if strings.Trim(parts[1], "0") == "" || strings.Trim(parts[2], "0") == "" {
+		return ErrInvalidTraceParent
+	}
+	return nil
+}
+
+func isHex(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+	for _, r := range value {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+package tracecontext
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewContextAndFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, err := NewContext(context.Background(), SpanContext{
+		TraceParent: "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		TraceState:  "vendor=value",
+	})
+	if err != nil {
+		t.Fatalf("new context: %v", err)
+	}
+
+	sc, ok := FromContext(ctx)
+	if !ok {
+		t.Fatalf("expected span context in context")
+	}
+	if sc.TraceParent != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" || sc.TraceState != "vendor=value" {
+		t.Fatalf("unexpected span context: %#v", sc)
+	}
+}
+
+func TestValidateTraceParent(t *testing.T) {
+	t.Parallel()
+
+	invalid := []string{
+		"",
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902bz-01",
+	}
+	for _, value := range invalid {
+		if err := ValidateTraceParent(value); !errors.Is(err, ErrInvalidTraceParent) {
+			t.Fatalf("expected ErrInvalidTraceParent for %q, got: %v", value, err)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	operationLogin = "login"
+
+	defaultKubernetesMount = "kubernetes"
+	// serviceAccountTokenPath is where Kubernetes mounts a pod's
+	// ServiceAccount JWT.
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+)
+
+// Auth is the token issued by a Vault auth method login. Seed a client with
+// it through New(address, auth.ClientToken).
+type Auth struct {
+	ClientToken string
+	Accessor    string
+	Policies    []string
+	Renewable   bool
+	// LeaseDuration is the token lifetime; zero means the token does not expire.
+	LeaseDuration time.Duration
+}
+
+// LoginKubernetes logs in with the kubernetes auth method at mount, defaulting
+// to "kubernetes", as role using a ServiceAccount JWT. An empty jwt is read
+// from the pod's mounted ServiceAccount token. The login request is sent
+// without the client's own token.
+func (c *Client) LoginKubernetes(ctx context.Context, mount string, role string, jwt string) (*Auth, error) {
+	return c.loginKubernetes(ctx, mount, role, jwt, serviceAccountTokenPath)
+}
+
+func (c *Client) loginKubernetes(
+	ctx context.Context,
+	mount string,
+	role string,
+	jwt string,
+	tokenPath string,
+) (*Auth, error) {
+	cleanMount := strings.Trim(strings.TrimSpace(mount), "/")
+	if cleanMount == "" {
+		cleanMount = defaultKubernetesMount
+	}
+	cleanRole := strings.TrimSpace(role)
+	if cleanRole == "" {
+		return nil, errors.New("kubernetes auth role must not be empty")
+	}
+
+	cleanJWT := strings.TrimSpace(jwt)
+	if cleanJWT == "" {
+		raw, err := os.ReadFile(tokenPath)
+		if err != nil {
+			return nil, fmt.Errorf("read service account token: %w", err)
+		}
+		cleanJWT = strings.TrimSpace(string(raw))
+		if cleanJWT == "" {
+			return nil, fmt.Errorf("service account token file is empty: %s", tokenPath)
+		}
+	}
+
+	return c.login(ctx, cleanMount, map[string]any{"role": cleanRole, "jwt": cleanJWT})
+}
+
+// login posts credentials to an auth method's login endpoint and returns the
+// issued token.
+func (c *Client) login(ctx context.Context, mount string, credentials map[string]any) (*Auth, error) {
+	var decoded struct {
+		Auth *struct {
+			ClientToken   string   `json:"client_token"`
+			Accessor      string   `json:"accessor"`
+			Policies      []string `json:"policies"`
+			Renewable     bool     `json:"renewable"`
+			LeaseDuration int64    `json:"lease_duration"`
+		} `json:"auth"`
+	}
+	if _, err := c.doJSON(ctx, operationLogin, http.MethodPost, "auth/"+mount+"/login", credentials, &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.Auth == nil || decoded.Auth.ClientToken == "" {
+		return nil, errors.New("vault login response missing client token")
+	}
+
+	return &Auth{
+		ClientToken:   decoded.Auth.ClientToken,
+		Accessor:      decoded.Auth.Accessor,
+		Policies:      decoded.Auth.Policies,
+		Renewable:     decoded.Auth.Renewable,
+		LeaseDuration: time.Duration(decoded.Auth.LeaseDuration) * time.Second,
+	}, nil
+}
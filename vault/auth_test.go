@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoginKubernetes(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if got := r.Header.Get("X-Vault-Token"); got != "" {
+			t.Errorf("login must not send a token, got %q", got)
+		}
+		var payload struct {
+			Role string `json:"role"`
+			JWT  string `json:"jwt"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode request body: %v", err)
+		}
+		if payload.Role != "app" {
+			http.Error(w, `{"errors":["invalid role name"]}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"auth": map[string]any{
+			"client_token":   "s.issued-" + r.URL.Path + "-" + payload.JWT,
+			"accessor":       "acc-1",
+			"policies":       []string{"default", "app"},
+			"renewable":      true,
+			"lease_duration": 3600,
+		}})
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "bootstrap-token")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenPath, []byte("file-jwt\n"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		mount     string
+		role      string
+		jwt       string
+		tokenPath string
+		wantToken string
+		wantErr   bool
+	}{
+		{name: "default mount", role: "app", jwt: "pod-jwt", wantToken: "s.issued-/v1/auth/kubernetes/login-pod-jwt"},
+		{name: "custom mount", mount: "/k8s-prod/", role: "app", jwt: "pod-jwt", wantToken: "s.issued-/v1/auth/k8s-prod/login-pod-jwt"},
+		{name: "jwt from file", role: "app", tokenPath: tokenPath, wantToken: "s.issued-/v1/auth/kubernetes/login-file-jwt"},
+		{name: "missing token file", role: "app", tokenPath: filepath.Join(t.TempDir(), "missing"), wantErr: true},
+		{name: "empty role", jwt: "pod-jwt", wantErr: true},
+		{name: "rejected role", role: "other", jwt: "pod-jwt", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		auth, err := client.loginKubernetes(context.Background(), tc.mount, tc.role, tc.jwt, tc.tokenPath)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("%s: expected error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: login: %v", tc.name, err)
+		}
+		if auth.ClientToken != tc.wantToken || auth.LeaseDuration != time.Hour || len(auth.Policies) != 2 {
+			t.Fatalf("%s: unexpected auth: %#v", tc.name, auth)
+		}
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
+	"github.com/d-padmanabhan/platform-core-go/autherr"
 	"github.com/d-padmanabhan/platform-core-go/internal/httpx"
 )
 
@@ -20,17 +24,54 @@ const (
 	envVaultAddr    = "VAULT_ADDR"
 	envVaultToken   = "VAULT_TOKEN"
 	envVaultTimeout = "VAULT_HTTP_TIMEOUT_SECONDS"
+
+	headerVaultIndex      = "X-Vault-Index"
+	defaultRetryBaseDelay = 250 * time.Millisecond
+	defaultRetryMaxDelay  = 5 * time.Second
 )
 
 // ErrSecretNotFound indicates a requested secret path does not exist.
 var ErrSecretNotFound = errors.New("vault secret not found")
 
+// ErrCASMismatch indicates a check-and-set write was rejected because the
+// secret changed since the version it was based on.
+var ErrCASMismatch = errors.New("vault check-and-set version mismatch")
+
 // Config controls Vault client behavior.
 type Config struct {
-	Address    string
-	Token      string
-	Timeout    time.Duration
-	HTTPClient *http.Client
+	Address        string
+	Token          string
+	Timeout        time.Duration
+	HTTPClient     *http.Client
+	RedirectPolicy func(req *http.Request, via []*http.Request) error
+	// UserAgent is sent on every request when non-empty. It defaults to
+	// "platform-core-go/<version>".
+	UserAgent string
+
+	ResponseHeaderTimeout   time.Duration
+	MaxResponseHeaderBytes  int64
+	TraceContextPropagation bool
+
+	// MaxRetries is the number of retries for retryable responses, and for
+	// writes whose connection was reset. Zero, the default, disables retries.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// RetryableStatus reports whether a response status is retried. When nil,
+	// 429, 5xx, and 412 index-not-ready responses are retried.
+	RetryableStatus func(statusCode int) bool
+	ReadYourWrites  bool
+
+	TokenExpiryThreshold time.Duration
+	TokenExpiryWarning   func(remaining time.Duration)
+
+	TLSCertFile           string
+	TLSKeyFile            string
+	TLSCertReloadInterval time.Duration
+
+	// Logger, when set, receives a debug record per attempt and scheduled
+	// retry. Only the method, URL path, status, and timings are logged.
+	Logger *slog.Logger
 }
 
 // Option configures Client construction behavior.
@@ -57,27 +98,151 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
-// WithHTTPClient injects a custom HTTP client.
+// WithHTTPClient injects a custom HTTP client. The client is copied before
+// other options are applied, so client itself is left unchanged.
 func WithHTTPClient(client *http.Client) Option {
 	return func(cfg *Config) {
 		cfg.HTTPClient = client
 	}
 }
 
+// WithResponseHeaderTimeout fails a request attempt when the server does not
+// start responding within timeout, independent of the overall client timeout.
+func WithResponseHeaderTimeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.ResponseHeaderTimeout = timeout
+	}
+}
+
+// WithMaxResponseHeaderBytes caps the size of response headers accepted from
+// the server. Clients built by this package default to 1 MiB; a custom
+// HTTPClient keeps its own transport setting unless this option is given.
+func WithMaxResponseHeaderBytes(n int64) Option {
+	return func(cfg *Config) {
+		cfg.MaxResponseHeaderBytes = n
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent on every request. An empty
+// value sends Go's default User-Agent.
+func WithUserAgent(ua string) Option {
+	return func(cfg *Config) {
+		cfg.UserAgent = strings.TrimSpace(ua)
+	}
+}
+
+// WithRedirectPolicy overrides how redirects are followed. By default only
+// same-host redirects are followed so the Vault token never leaves the server.
+func WithRedirectPolicy(policy func(req *http.Request, via []*http.Request) error) Option {
+	return func(cfg *Config) {
+		cfg.RedirectPolicy = policy
+	}
+}
+
+// WithTraceContextPropagation sends the W3C traceparent and tracestate headers
+// of the span context stored with tracecontext.NewContext on every request.
+func WithTraceContextPropagation() Option {
+	return func(cfg *Config) {
+		cfg.TraceContextPropagation = true
+	}
+}
+
+// WithLogger logs each request attempt and scheduled retry at debug level with
+// the method, URL path, status, attempt number, duration, and backoff delay.
+// Tokens and secret values are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(cfg *Config) {
+		cfg.Logger = logger
+	}
+}
+
+// WithRetries retries requests rejected with 412 because the replicated index
+// state is not ready yet, with 429 or a 5xx such as 503 from a standby node,
+// and writes whose connection was reset, using exponential backoff.
+func WithRetries(maxRetries int, baseDelay, maxDelay time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.MaxRetries = maxRetries
+		cfg.RetryBaseDelay = baseDelay
+		cfg.RetryMaxDelay = maxDelay
+	}
+}
+
+// WithRetryableStatus replaces the predicate that decides which response
+// statuses WithRetries retries, for Vault topologies whose transient statuses
+// differ from the default. The predicate sees only the status code, so a 412
+// it accepts is retried whatever its cause.
+func WithRetryableStatus(retryable func(statusCode int) bool) Option {
+	return func(cfg *Config) {
+		cfg.RetryableStatus = retryable
+	}
+}
+
+// WithReadYourWrites sends the most recent X-Vault-Index returned by the server
+// on subsequent requests so reads observe earlier writes on replicated clusters.
+// Combine with WithRetries so requests wait for the index to become available.
+func WithReadYourWrites() Option {
+	return func(cfg *Config) {
+		cfg.ReadYourWrites = true
+	}
+}
+
+// WithTokenExpiryWarning calls warn once when a request is made while the
+// token's remaining TTL is below threshold. The TTL is looked up with
+// LookupSelf on the first request.
+func WithTokenExpiryWarning(threshold time.Duration, warn func(remaining time.Duration)) Option {
+	return func(cfg *Config) {
+		cfg.TokenExpiryThreshold = threshold
+		cfg.TokenExpiryWarning = warn
+	}
+}
+
+// WithReloadingTLSCert presents a client certificate that is reloaded from disk
+// when the files change, checked at most once per interval.
+func WithReloadingTLSCert(certFile, keyFile string, interval time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.TLSCertFile = strings.TrimSpace(certFile)
+		cfg.TLSKeyFile = strings.TrimSpace(keyFile)
+		cfg.TLSCertReloadInterval = interval
+	}
+}
+
 // Client provides Vault KV v2 read/write operations.
 type Client struct {
 	address    string
 	token      string
 	httpClient *http.Client
+	cfg        Config
+
+	indexMu   sync.Mutex
+	lastIndex string
+
+	expiryWatch *tokenExpiryWatch
+	stats       httpx.Stats
+}
+
+// ClientStats is a snapshot of a client's request, retry, failure, and
+// per-status-class counters.
+type ClientStats = httpx.ClientStats
+
+// Stats returns the client's request counters. A request counts as a failure
+// when it gets no 2xx response after any retries.
+func (c *Client) Stats() ClientStats {
+	return c.stats.Snapshot()
+}
+
+// ResetStats sets the client's request counters back to zero.
+func (c *Client) ResetStats() {
+	c.stats.Reset()
 }
 
 // NewFromEnv creates a Vault client from environment variables.
 func NewFromEnv(opts ...Option) (*Client, error) {
 	timeoutSeconds := getenvInt(envVaultTimeout, int(httpx.DefaultTimeout.Seconds()))
 	cfg := Config{
-		Address: strings.TrimRight(strings.TrimSpace(os.Getenv(envVaultAddr)), "/"),
-		Token:   strings.TrimSpace(os.Getenv(envVaultToken)),
-		Timeout: time.Duration(timeoutSeconds) * time.Second,
+		Address:   strings.TrimRight(strings.TrimSpace(os.Getenv(envVaultAddr)), "/"),
+		Token:     strings.TrimSpace(os.Getenv(envVaultToken)),
+		Timeout:   time.Duration(timeoutSeconds) * time.Second,
+		UserAgent: httpx.DefaultUserAgent(),
 	}
 	for _, opt := range opts {
 		opt(&cfg)
@@ -89,9 +254,10 @@ func NewFromEnv(opts ...Option) (*Client, error) {
 // New creates a Vault client from explicit address and token values.
 func New(address string, token string, opts ...Option) (*Client, error) {
 	cfg := Config{
-		Address: strings.TrimRight(strings.TrimSpace(address), "/"),
-		Token:   strings.TrimSpace(token),
-		Timeout: httpx.DefaultTimeout,
+		Address:   strings.TrimRight(strings.TrimSpace(address), "/"),
+		Token:     strings.TrimSpace(token),
+		Timeout:   httpx.DefaultTimeout,
+		UserAgent: httpx.DefaultUserAgent(),
 	}
 	for _, opt := range opts {
 		opt(&cfg)
@@ -110,18 +276,70 @@ func newClient(cfg Config) (*Client, error) {
 	if cfg.Timeout <= 0 {
 		cfg.Timeout = httpx.DefaultTimeout
 	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = defaultRetryBaseDelay
+	}
+	if cfg.RetryMaxDelay <= 0 {
+		cfg.RetryMaxDelay = defaultRetryMaxDelay
+	}
 
 	if cfg.HTTPClient == nil {
 		cfg.HTTPClient = httpx.NewClient(cfg.Timeout)
-	} else if cfg.HTTPClient.Timeout <= 0 {
-		cfg.HTTPClient.Timeout = cfg.Timeout
+	} else {
+		// Settings below are applied to a copy so a caller's client, which
+		// may be shared with other clients, is never modified.
+		cfg.HTTPClient = httpx.CopyClient(cfg.HTTPClient)
+		if cfg.HTTPClient.Timeout <= 0 {
+			cfg.HTTPClient.Timeout = cfg.Timeout
+		}
+	}
+	httpx.ApplyRedirectPolicy(cfg.HTTPClient, cfg.RedirectPolicy)
+	if err := httpx.ApplyResponseHeaderTimeout(cfg.HTTPClient, cfg.ResponseHeaderTimeout); err != nil {
+		return nil, err
+	}
+	if err := httpx.ApplyMaxResponseHeaderBytes(cfg.HTTPClient, cfg.MaxResponseHeaderBytes); err != nil {
+		return nil, err
+	}
+
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		reloader, err := newCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSCertReloadInterval)
+		if err != nil {
+			return nil, err
+		}
+		if err := applyClientCertificate(cfg.HTTPClient, reloader); err != nil {
+			return nil, err
+		}
 	}
+	if cfg.TraceContextPropagation {
+		cfg.HTTPClient.Transport = httpx.NewTraceContextTransport(cfg.HTTPClient.Transport)
+	}
+
+	token := cfg.Token
+	cfg.Token = ""
 
-	return &Client{
+	client := &Client{
 		address:    cfg.Address,
-		token:      cfg.Token,
+		token:      token,
 		httpClient: cfg.HTTPClient,
-	}, nil
+		cfg:        cfg,
+	}
+	if cfg.TokenExpiryThreshold > 0 && cfg.TokenExpiryWarning != nil {
+		client.expiryWatch = &tokenExpiryWatch{
+			threshold: cfg.TokenExpiryThreshold,
+			warn:      cfg.TokenExpiryWarning,
+			now:       time.Now,
+		}
+	}
+	return client, nil
+}
+
+// Config returns a copy of the effective client configuration. The token is
+// always omitted so the result is safe to log.
+func (c *Client) Config() Config {
+	return c.cfg
 }
 
 // WriteKVv2 writes secret data to a KV v2 path.
@@ -130,86 +348,441 @@ func (c *Client) WriteKVv2(
 	secretsEngine string,
 	secretPath string,
 	credentials map[string]any,
+) error {
+	return c.writeKVv2(ctx, secretsEngine, secretPath, credentials, -1)
+}
+
+// WriteKVv2CAS writes secret data only if the secret's current version is
+// expectedVersion, using 0 to require that the path has never been written.
+// When another writer got there first the write is rejected with
+// ErrCASMismatch, and callers can re-read and retry. A dropped connection is
+// not retried even with WithRetries: Vault may have applied the write, and
+// resending it would report ErrCASMismatch for a write that succeeded.
+func (c *Client) WriteKVv2CAS(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	credentials map[string]any,
+	expectedVersion int,
+) error {
+	if expectedVersion < 0 {
+		return errors.New("expected secret version must not be negative")
+	}
+	return c.writeKVv2(ctx, secretsEngine, secretPath, credentials, expectedVersion)
+}
+
+// operationCASWrite names check-and-set writes, which are not resent after a
+// dropped connection.
+const operationCASWrite = "check-and-set write"
+
+// writeKVv2 writes secret data, passing cas as the check-and-set version when
+// it is not negative. A rejected check-and-set is reported as ErrCASMismatch.
+func (c *Client) writeKVv2(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	credentials map[string]any,
+	cas int,
 ) error {
 	vaultURL, err := c.kvV2URL(secretsEngine, secretPath)
 	if err != nil {
 		return err
 	}
 
+	operation := "write"
 	payload := map[string]any{"data": credentials}
+	if cas >= 0 {
+		operation = operationCASWrite
+		payload["options"] = map[string]any{"cas": cas}
+	}
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("marshal vault write payload: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, vaultURL, bytes.NewReader(body))
+	statusCode, responseBody, err := c.send(ctx, operation, http.MethodPost, vaultURL, body)
 	if err != nil {
-		return fmt.Errorf("create vault write request: %w", err)
+		return err
 	}
-	req.Header.Set("X-Vault-Token", c.token)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("vault write request failed: %w", err)
+	if cas >= 0 && statusCode == http.StatusBadRequest && bytes.Contains(responseBody, []byte("check-and-set")) {
+		return fmt.Errorf("%w: %s", ErrCASMismatch, secretPath)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	responseBody, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("vault write failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(responseBody)))
+	if statusCode < 200 || statusCode >= 300 {
+		return statusError("write", statusCode, responseBody)
 	}
 
 	return nil
 }
 
-// ReadKVv2 reads secret data from a KV v2 path.
+// ReadKVv2 reads the latest secret data from a KV v2 path.
 func (c *Client) ReadKVv2(ctx context.Context, secretsEngine string, secretPath string) (map[string]any, error) {
-	vaultURL, err := c.kvV2URL(secretsEngine, secretPath)
+	return c.ReadKVv2Version(ctx, secretsEngine, secretPath, 0)
+}
+
+// ReadKVv2Version reads a specific version of a KV v2 secret, or the latest
+// when version is 0. A missing, deleted, or destroyed version is reported as
+// ErrSecretNotFound.
+func (c *Client) ReadKVv2Version(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	version int,
+) (map[string]any, error) {
+	data, _, err := c.readKVv2At(ctx, secretsEngine, secretPath, version)
+	return data, err
+}
+
+// ReadKVv2OrDefault reads secret data from a KV v2 path, returning def when
+// the path does not exist. Other failures are returned as errors.
+func (c *Client) ReadKVv2OrDefault(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	def map[string]any,
+) (map[string]any, error) {
+	data, err := c.ReadKVv2(ctx, secretsEngine, secretPath)
+	if errors.Is(err, ErrSecretNotFound) {
+		return def, nil
+	}
 	if err != nil {
 		return nil, err
 	}
+	return data, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, vaultURL, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create vault read request: %w", err)
+// ReadKVv2WithFallback reads secretPath from each mount in order and returns
+// the data with the mount that held it, for example while migrating secrets
+// between mounts. It returns ErrSecretNotFound only when every mount misses;
+// any other failure stops the search.
+func (c *Client) ReadKVv2WithFallback(
+	ctx context.Context,
+	secretPath string,
+	mounts []string,
+) (map[string]any, string, error) {
+	if len(mounts) == 0 {
+		return nil, "", errors.New("at least one secrets engine mount must be provided")
 	}
-	req.Header.Set("X-Vault-Token", c.token)
 
-	resp, err := c.httpClient.Do(req)
+	for _, mount := range mounts {
+		data, err := c.ReadKVv2(ctx, mount, secretPath)
+		if errors.Is(err, ErrSecretNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("read %s from mount %s: %w", secretPath, mount, err)
+		}
+		return data, mount, nil
+	}
+	return nil, "", fmt.Errorf("%w: %s in mounts %s", ErrSecretNotFound, secretPath, strings.Join(mounts, ", "))
+}
+
+// readKVv2At is ReadKVv2Version that also returns the version number read, as
+// reported in the response metadata. When a deleted or destroyed version is
+// reported as ErrSecretNotFound, the version is still returned if Vault sent
+// it, so callers can tell it apart from a path with no versions (0).
+func (c *Client) readKVv2At(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	version int,
+) (map[string]any, int, error) {
+	vaultURL, err := c.kvV2URL(secretsEngine, secretPath)
 	if err != nil {
-		return nil, fmt.Errorf("vault read request failed: %w", err)
+		return nil, 0, err
+	}
+	if version < 0 {
+		return nil, 0, errors.New("secret version must not be negative")
+	}
+	if version > 0 {
+		vaultURL += "?version=" + strconv.Itoa(version)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
-	responseBody, _ := io.ReadAll(resp.Body)
-	if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	statusCode, responseBody, err := c.send(ctx, "read", http.MethodGet, vaultURL, nil)
+	if err != nil {
+		return nil, 0, err
 	}
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("vault read failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(responseBody)))
+	if statusCode != http.StatusNotFound && (statusCode < 200 || statusCode >= 300) {
+		return nil, 0, statusError("read", statusCode, responseBody)
 	}
 
 	var decoded struct {
 		Data struct {
-			Data map[string]any `json:"data"`
+			Data     map[string]any `json:"data"`
+			Metadata struct {
+				Version int `json:"version"`
+			} `json:"metadata"`
 		} `json:"data"`
 	}
+	if statusCode == http.StatusNotFound {
+		// Vault answers a deleted or destroyed version with 404 and its
+		// metadata; a path that never existed has no body to decode.
+		_ = json.Unmarshal(responseBody, &decoded)
+		return nil, decoded.Data.Metadata.Version, fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
 	if err := json.Unmarshal(responseBody, &decoded); err != nil {
-		return nil, fmt.Errorf("decode vault read response: %w", err)
+		return nil, 0, fmt.Errorf("decode vault read response: %w", err)
 	}
 	if decoded.Data.Data == nil {
-		return nil, fmt.Errorf("vault response missing secret data at path: %s", secretPath)
+		if version > 0 {
+			return nil, version, fmt.Errorf("%w: %s version %d", ErrSecretNotFound, secretPath, version)
+		}
+		return nil, decoded.Data.Metadata.Version, fmt.Errorf("%w: %s latest version deleted or destroyed", ErrSecretNotFound, secretPath)
 	}
 
-	return decoded.Data.Data, nil
+	return decoded.Data.Data, decoded.Data.Metadata.Version, nil
+}
+
+// doJSON sends a Vault API request for path (relative to /v1/) and decodes the
+// JSON response into out. Non-2xx responses are returned as errors alongside
+// the status code so callers can map specific statuses.
+func (c *Client) doJSON(
+	ctx context.Context,
+	operation string,
+	method string,
+	path string,
+	requestBody any,
+	out any,
+) (int, error) {
+	var payload []byte
+	if requestBody != nil {
+		var err error
+		payload, err = json.Marshal(requestBody)
+		if err != nil {
+			return 0, fmt.Errorf("marshal vault %s payload: %w", operation, err)
+		}
+	}
+
+	vaultURL := fmt.Sprintf("%s/v1/%s", c.address, strings.TrimLeft(path, "/"))
+	statusCode, responseBody, err := c.send(ctx, operation, method, vaultURL, payload)
+	if err != nil {
+		return 0, err
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		return statusCode, statusError(operation, statusCode, responseBody)
+	}
+
+	if out != nil && len(bytes.TrimSpace(responseBody)) > 0 {
+		if err := json.Unmarshal(responseBody, out); err != nil {
+			return statusCode, fmt.Errorf("decode vault %s response: %w", operation, err)
+		}
+	}
+
+	return statusCode, nil
+}
+
+// statusError describes a non-2xx response. A 401 or 403 wraps
+// autherr.ErrUnauthorized or autherr.ErrForbidden; Vault answers an invalid or
+// expired token with 403.
+func statusError(operation string, statusCode int, body []byte) error {
+	err := fmt.Errorf("vault %s failed with status %d: %s", operation, statusCode, strings.TrimSpace(string(body)))
+	if sentinel := autherr.FromStatus(statusCode); sentinel != nil {
+		return fmt.Errorf("%w: %w", sentinel, err)
+	}
+	return err
+}
+
+// send performs a Vault request and returns the status code and body. Requests
+// rejected because the replicated index is not ready, or rate limited, are
+// retried when retries are enabled; other statuses are left to the caller.
+func (c *Client) send(
+	ctx context.Context,
+	operation string,
+	method string,
+	vaultURL string,
+	payload []byte,
+) (int, []byte, error) {
+	c.stats.RecordRequest()
+	statusCode, body, err := c.sendAttempts(ctx, operation, method, vaultURL, payload)
+	if err != nil || statusCode < 200 || statusCode >= 300 {
+		c.stats.RecordFailure()
+	}
+	return statusCode, body, err
+}
+
+func (c *Client) sendAttempts(
+	ctx context.Context,
+	operation string,
+	method string,
+	vaultURL string,
+	payload []byte,
+) (int, []byte, error) {
+	if c.expiryWatch != nil && operation != operationTokenLookup && operation != operationLogin {
+		c.expiryWatch.check(ctx, c)
+	}
+
+	for attempt := 0; ; attempt++ {
+		req, err := c.newRequest(ctx, operation, method, vaultURL, payload)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		started := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.logAttempt(ctx, method, vaultURL, attempt, 0, started, err)
+			if attempt < c.cfg.MaxRetries && isWriteMethod(method) && operation != operationCASWrite && isConnectionReset(err) {
+				if err := c.sleepBeforeRetry(ctx, method, vaultURL, attempt, "transport:connection_reset"); err != nil {
+					return 0, nil, err
+				}
+				c.stats.RecordRetry()
+				continue
+			}
+			return 0, nil, fmt.Errorf("vault %s request failed: %w", operation, err)
+		}
+		c.stats.RecordStatus(resp.StatusCode)
+		responseBody, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		c.logAttempt(ctx, method, vaultURL, attempt, resp.StatusCode, started, readErr)
+		if readErr != nil {
+			return 0, nil, fmt.Errorf("read vault %s response: %w", operation, readErr)
+		}
+		c.recordVaultIndex(resp.Header.Get(headerVaultIndex))
+
+		if attempt < c.cfg.MaxRetries && c.retryableStatus(resp.StatusCode, responseBody) {
+			reason := fmt.Sprintf("status:%d", resp.StatusCode)
+			if err := c.sleepBeforeRetry(ctx, method, vaultURL, attempt, reason); err != nil {
+				return 0, nil, err
+			}
+			c.stats.RecordRetry()
+			continue
+		}
+
+		return resp.StatusCode, responseBody, nil
+	}
+}
+
+// newRequest builds a Vault request with the token, User-Agent, and
+// read-your-writes index headers. Login requests carry no token.
+func (c *Client) newRequest(
+	ctx context.Context,
+	operation string,
+	method string,
+	vaultURL string,
+	payload []byte,
+) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, vaultURL, body)
+	if err != nil {
+		return nil, fmt.Errorf("create vault %s request: %w", operation, err)
+	}
+	if operation != operationLogin {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+	if c.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", contentType(method))
+	}
+	if index := c.vaultIndex(); index != "" {
+		req.Header.Set(headerVaultIndex, index)
+	}
+	return req, nil
+}
+
+func (c *Client) sleepBeforeRetry(
+	ctx context.Context,
+	method string,
+	vaultURL string,
+	attempt int,
+	reason string,
+) error {
+	delay := httpx.ExponentialBackoffDelay(attempt, c.cfg.RetryBaseDelay, c.cfg.RetryMaxDelay, false, 0)
+	httpx.LogRetry(ctx, c.cfg.Logger, method, vaultURL, attempt, delay, reason)
+	return httpx.SleepContext(ctx, delay)
+}
+
+func (c *Client) logAttempt(
+	ctx context.Context,
+	method string,
+	vaultURL string,
+	attempt int,
+	status int,
+	started time.Time,
+	err error,
+) {
+	httpx.LogAttempt(ctx, c.cfg.Logger, method, vaultURL, attempt, status, time.Since(started), err)
+}
+
+// contentType returns the request body media type for method. Vault only
+// accepts JSON merge patches on PATCH endpoints.
+func contentType(method string) string {
+	if method == http.MethodPatch {
+		return "application/merge-patch+json"
+	}
+	return "application/json"
+}
+
+func isWriteMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut
+}
+
+// isConnectionReset reports whether a write failed because the connection was
+// dropped, typically by a load balancer. Vault applies a write fully or not at
+// all, so resending it is safe, except for a check-and-set write: if the first
+// attempt was applied, the resend fails the version check.
+func isConnectionReset(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// retryableStatus applies Config.RetryableStatus, falling back to
+// shouldRetryStatus.
+func (c *Client) retryableStatus(statusCode int, body []byte) bool {
+	if c.cfg.RetryableStatus != nil {
+		return c.cfg.RetryableStatus(statusCode)
+	}
+	return shouldRetryStatus(statusCode, body)
+}
+
+// shouldRetryStatus reports whether a response is transient: 429, a 5xx such
+// as 503 from a sealed or standby node, or a 412 signalling that the requested
+// X-Vault-Index state has not replicated yet.
+func shouldRetryStatus(statusCode int, body []byte) bool {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode >= 500 && statusCode <= 599:
+		return true
+	case statusCode == http.StatusPreconditionFailed:
+		return bytes.Contains(body, []byte("required index state not present"))
+	default:
+		return false
+	}
+}
+
+func (c *Client) vaultIndex() string {
+	if !c.cfg.ReadYourWrites {
+		return ""
+	}
+	c.indexMu.Lock()
+	defer c.indexMu.Unlock()
+	return c.lastIndex
+}
+
+func (c *Client) recordVaultIndex(index string) {
+	if !c.cfg.ReadYourWrites || index == "" {
+		return
+	}
+	c.indexMu.Lock()
+	c.lastIndex = index
+	c.indexMu.Unlock()
 }
 
 func (c *Client) kvV2URL(secretsEngine string, secretPath string) (string, error) {
+	path, err := kvV2Path(secretsEngine, "data", secretPath)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/v1/%s", c.address, path), nil
+}
+
+// kvV2Path returns the /v1/-relative path for a KV v2 endpoint segment such as
+// "data", "metadata", "delete", "undelete", or "destroy".
+func kvV2Path(secretsEngine string, segment string, secretPath string) (string, error) {
 	mount := strings.Trim(strings.TrimSpace(secretsEngine), "/")
 	path := strings.Trim(strings.TrimSpace(secretPath), "/")
 	if mount == "" {
@@ -219,7 +792,15 @@ func (c *Client) kvV2URL(secretsEngine string, secretPath string) (string, error
 		return "", errors.New("secret path must not be empty")
 	}
 
-	return fmt.Sprintf("%s/v1/%s/data/%s", c.address, mount, path), nil
+	return fmt.Sprintf("%s/%s/%s", mount, segment, path), nil
+}
+
+func trimPathSegment(value string, name string) (string, error) {
+	trimmed := strings.Trim(strings.TrimSpace(value), "/")
+	if trimmed == "" {
+		return "", fmt.Errorf("%s must not be empty", name)
+	}
+	return trimmed, nil
 }
 
 func getenvInt(key string, fallback int) int {
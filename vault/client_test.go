@@ -1,12 +1,22 @@
 package vault
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
+
+	"github.com/d-padmanabhan/platform-core-go/autherr"
 )
 
 func TestWriteAndReadKVv2(t *testing.T) {
@@ -89,3 +99,636 @@ func TestReadKVv2NotFound(t *testing.T) {
 		t.Fatalf("expected ErrSecretNotFound, got: %v", err)
 	}
 }
+
+func TestWriteKVv2CAS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(&casServer{data: map[string]any{"password": "old"}, version: 2})
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+	data := map[string]any{"password": "new"}
+
+	if err := client.WriteKVv2CAS(ctx, "secret", "team/app", data, 1); !errors.Is(err, ErrCASMismatch) {
+		t.Fatalf("expected ErrCASMismatch for stale version, got: %v", err)
+	}
+	if err := client.WriteKVv2CAS(ctx, "secret", "team/app", data, 2); err != nil {
+		t.Fatalf("write with current version: %v", err)
+	}
+	if err := client.WriteKVv2CAS(ctx, "secret", "team/app", data, -1); err == nil {
+		t.Fatalf("expected negative version validation error")
+	}
+}
+
+func TestReadKVv2Version(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/team/app" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		var data any
+		switch version := r.URL.Query().Get("version"); version {
+		case "", "2":
+			data = map[string]any{"password": "pass-v2"}
+		case "1":
+			data = map[string]any{"password": "pass-v1"}
+		case "3":
+			// Destroyed versions come back with null data.
+			data = nil
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"data": data, "metadata": map[string]any{"version": 2}},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		version      int
+		wantPassword string
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{name: "latest", version: 0, wantPassword: "pass-v2"},
+		{name: "older version", version: 1, wantPassword: "pass-v1"},
+		{name: "destroyed version", version: 3, wantNotFound: true},
+		{name: "missing version", version: 9, wantNotFound: true},
+		{name: "negative version", version: -1, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := client.ReadKVv2Version(context.Background(), "secret", "team/app", tc.version)
+			switch {
+			case tc.wantNotFound:
+				if !errors.Is(err, ErrSecretNotFound) {
+					t.Fatalf("expected ErrSecretNotFound, got: %v", err)
+				}
+			case tc.wantErr:
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+			case err != nil:
+				t.Fatalf("read version: %v", err)
+			case data["password"] != tc.wantPassword:
+				t.Fatalf("unexpected data: %#v", data)
+			}
+		})
+	}
+}
+
+func TestStatusErrorsMatchAuthSentinels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{status: http.StatusUnauthorized, want: autherr.ErrUnauthorized},
+		{status: http.StatusForbidden, want: autherr.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			http.Error(w, `{"errors":["permission denied"]}`, tt.status)
+		}))
+
+		client, err := New(server.URL, "token-123")
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+		_, readErr := client.ReadKVv2(context.Background(), "secret", "app")
+		writeErr := client.WriteKVv2(context.Background(), "secret", "app", map[string]any{"k": "v"})
+		server.Close()
+
+		for _, err := range []error{readErr, writeErr} {
+			if !errors.Is(err, tt.want) || !strings.Contains(err.Error(), "permission denied") {
+				t.Fatalf("status %d: expected %v with details, got %v", tt.status, tt.want, err)
+			}
+		}
+	}
+}
+
+func TestReadKVv2DoesNotFollowCrossHostRedirect(t *testing.T) {
+	t.Parallel()
+
+	var leaked bool
+	attacker := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		leaked = r.Header.Get("X-Vault-Token") != ""
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer attacker.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, attacker.URL, http.StatusFound)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if _, err := client.ReadKVv2(context.Background(), "secret", "team/app"); err == nil {
+		t.Fatalf("expected cross-host redirect to fail")
+	}
+	if leaked {
+		t.Fatalf("vault token was forwarded to redirect target")
+	}
+}
+
+func TestConfigOmitsToken(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://vault.example.com/", "token-123", WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	cfg := client.Config()
+	if cfg.Token != "" {
+		t.Fatalf("expected token to be omitted from config")
+	}
+	if cfg.Address != "https://vault.example.com" || cfg.Timeout != 5*time.Second {
+		t.Fatalf("unexpected effective config: %#v", cfg)
+	}
+}
+
+func TestReadKVv2OrDefault(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/secret/data/present":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"data":{"mode":"custom"}}}`))
+		case "/v1/secret/data/broken":
+			http.Error(w, "permission denied", http.StatusForbidden)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	def := map[string]any{"mode": "default"}
+
+	got, err := client.ReadKVv2OrDefault(context.Background(), "secret", "missing", def)
+	if err != nil || got["mode"] != "default" {
+		t.Fatalf("expected default for missing path, got: %#v err=%v", got, err)
+	}
+
+	got, err = client.ReadKVv2OrDefault(context.Background(), "secret", "present", def)
+	if err != nil || got["mode"] != "custom" {
+		t.Fatalf("expected stored data, got: %#v err=%v", got, err)
+	}
+
+	if _, err := client.ReadKVv2OrDefault(context.Background(), "secret", "broken", def); err == nil {
+		t.Fatalf("expected error for non-404 failure")
+	}
+}
+
+func TestReadKVv2RetriesIndexNotReady(t *testing.T) {
+	t.Parallel()
+
+	var reads atomic.Int32
+	var readIndex string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("X-Vault-Index", "index-after-write")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if reads.Add(1) == 1 {
+			http.Error(w, `{"errors":["required index state not present"]}`, http.StatusPreconditionFailed)
+			return
+		}
+		readIndex = r.Header.Get("X-Vault-Index")
+		_, _ = w.Write([]byte(`{"data":{"data":{"username":"app"}}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(
+		server.URL,
+		"token-123",
+		WithTimeout(5*time.Second),
+		WithRetries(2, time.Millisecond, time.Millisecond),
+		WithReadYourWrites(),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.WriteKVv2(context.Background(), "kv", "apps/demo", map[string]any{"username": "app"}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	data, err := client.ReadKVv2(context.Background(), "kv", "apps/demo")
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if data["username"] != "app" {
+		t.Fatalf("unexpected data: %#v", data)
+	}
+	if reads.Load() != 2 {
+		t.Fatalf("expected 2 read attempts, got %d", reads.Load())
+	}
+	if readIndex != "index-after-write" {
+		t.Fatalf("expected X-Vault-Index from write, got %q", readIndex)
+	}
+}
+
+func TestReadKVv2DoesNotRetryByDefault(t *testing.T) {
+	t.Parallel()
+
+	var reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		reads.Add(1)
+		http.Error(w, `{"errors":["required index state not present"]}`, http.StatusPreconditionFailed)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123", WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if _, err := client.ReadKVv2(context.Background(), "kv", "apps/demo"); err == nil {
+		t.Fatalf("expected 412 error")
+	}
+	if reads.Load() != 1 {
+		t.Fatalf("expected a single attempt, got %d", reads.Load())
+	}
+}
+
+type resetOnceTransport struct {
+	base  http.RoundTripper
+	calls atomic.Int32
+}
+
+func (t *resetOnceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.calls.Add(1) == 1 {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}
+	return t.base.RoundTrip(req)
+}
+
+func TestWriteKVv2RetriesConnectionReset(t *testing.T) {
+	t.Parallel()
+
+	var writes atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if payload["data"]["username"] != "app" {
+			http.Error(w, "unexpected payload", http.StatusBadRequest)
+			return
+		}
+		writes.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := &resetOnceTransport{base: http.DefaultTransport}
+	client, err := New(
+		server.URL,
+		"token-123",
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetries(1, time.Millisecond, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if err := client.WriteKVv2(context.Background(), "kv", "apps/demo", map[string]any{"username": "app"}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if transport.calls.Load() != 2 || writes.Load() != 1 {
+		t.Fatalf("expected one reset and one successful write, got %d attempts and %d writes", transport.calls.Load(), writes.Load())
+	}
+
+	transport.calls.Store(0)
+	if _, err := client.ReadKVv2(context.Background(), "kv", "apps/demo"); err == nil {
+		t.Fatalf("expected reads not to retry connection resets")
+	}
+}
+
+func TestWriteKVv2CASDoesNotRetryConnectionReset(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := &resetOnceTransport{base: http.DefaultTransport}
+	client, err := New(
+		server.URL,
+		"token-123",
+		WithHTTPClient(&http.Client{Transport: transport}),
+		WithRetries(1, time.Millisecond, time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	err = client.WriteKVv2CAS(context.Background(), "kv", "apps/demo", map[string]any{"username": "app"}, 3)
+	if err == nil || errors.Is(err, ErrCASMismatch) || !errors.Is(err, syscall.ECONNRESET) {
+		t.Fatalf("expected the connection reset to be returned, got %v", err)
+	}
+	if transport.calls.Load() != 1 {
+		t.Fatalf("expected a single attempt, got %d", transport.calls.Load())
+	}
+
+	transport.calls.Store(0)
+	cas := 3
+	err = client.WriteKVv2WithOptions(context.Background(), "kv", "apps/demo", map[string]any{"username": "app"}, KVv2WriteOptions{CAS: &cas})
+	if !errors.Is(err, syscall.ECONNRESET) || transport.calls.Load() != 1 {
+		t.Fatalf("expected WriteKVv2WithOptions not to retry a CAS write, got %v after %d attempts", err, transport.calls.Load())
+	}
+}
+
+func TestUserAgent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		opts   []Option
+		wantUA string
+	}{
+		{name: "default", wantUA: "platform-core-go/"},
+		{name: "custom", opts: []Option{WithUserAgent("deployer/1.2")}, wantUA: "deployer/1.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got atomic.Value
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got.Store(r.Header.Get("User-Agent"))
+				http.Error(w, "not found", http.StatusNotFound)
+			}))
+			defer server.Close()
+
+			client, err := New(server.URL, "token-123", tt.opts...)
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			_, _ = client.ReadKVv2(context.Background(), "secret", "app")
+			if ua, _ := got.Load().(string); !strings.HasPrefix(ua, tt.wantUA) {
+				t.Fatalf("expected User-Agent %q, got %q", tt.wantUA, ua)
+			}
+		})
+	}
+}
+
+func TestClientStats(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"data":{"k":"v"},"metadata":{"version":1}}}`))
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123", WithRetries(2, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.ReadKVv2(ctx, "secret", "app"); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if _, err := client.ReadKVv2(ctx, "secret", "missing"); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound, got %v", err)
+	}
+
+	want := ClientStats{Requests: 2, Retries: 1, Failures: 1, Status2xx: 1, Status4xx: 2}
+	if got := client.Stats(); got != want {
+		t.Fatalf("unexpected stats: %+v", got)
+	}
+	client.ResetStats()
+	if got := client.Stats(); got != (ClientStats{}) {
+		t.Fatalf("expected zero stats after reset, got %+v", got)
+	}
+}
+
+func TestReadKVv2WithFallback(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/legacy/data/app/db":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"s3cret"},"metadata":{"version":3}}}`))
+		case "/v1/broken/data/app/db":
+			http.Error(w, "permission denied", http.StatusForbidden)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	data, mount, err := client.ReadKVv2WithFallback(ctx, "app/db", []string{"secret", "legacy"})
+	if err != nil {
+		t.Fatalf("read with fallback: %v", err)
+	}
+	if mount != "legacy" || data["password"] != "s3cret" {
+		t.Fatalf("unexpected result from mount %q: %#v", mount, data)
+	}
+
+	if _, _, err := client.ReadKVv2WithFallback(ctx, "app/db", []string{"secret", "other"}); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound, got %v", err)
+	}
+	if _, _, err := client.ReadKVv2WithFallback(ctx, "app/db", []string{"broken", "legacy"}); err == nil || errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected non-not-found error to stop the search, got %v", err)
+	}
+	if _, _, err := client.ReadKVv2WithFallback(ctx, "app/db", nil); err == nil {
+		t.Fatalf("expected empty mounts validation error")
+	}
+}
+
+func TestWithRetryableStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		opts      []Option
+		status    int
+		wantCalls int32
+	}{
+		{name: "default retries standby 503", status: http.StatusServiceUnavailable, wantCalls: 2},
+		{name: "default does not retry 412 without index message", status: http.StatusPreconditionFailed, wantCalls: 1},
+		{
+			name:      "override disables 503",
+			opts:      []Option{WithRetryableStatus(func(code int) bool { return code == http.StatusTooManyRequests })},
+			status:    http.StatusServiceUnavailable,
+			wantCalls: 1,
+		},
+		{
+			name:      "override retries any 412",
+			opts:      []Option{WithRetryableStatus(func(code int) bool { return code == http.StatusPreconditionFailed })},
+			status:    http.StatusPreconditionFailed,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if calls.Add(1) == 1 {
+					http.Error(w, `{"errors":["transient"]}`, tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{"data":{"k":"v"}}}`))
+			}))
+			defer server.Close()
+
+			opts := append([]Option{WithRetries(1, time.Millisecond, time.Millisecond)}, tt.opts...)
+			client, err := New(server.URL, "token-123", opts...)
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			_, err = client.ReadKVv2(context.Background(), "kv", "apps/demo")
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Fatalf("expected %d attempts, got %d", tt.wantCalls, got)
+			}
+			if (err == nil) != (tt.wantCalls == 2) {
+				t.Fatalf("unexpected read error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWithLoggerOmitsSecrets(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"hunter2"}}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := New(server.URL, "token-123", WithLogger(logger))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.WriteKVv2(ctx, "kv", "apps/demo", map[string]any{"password": "hunter2"}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if _, err := client.ReadKVv2(ctx, "kv", "apps/demo"); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+
+	out := buf.String()
+	if strings.Contains(out, "token-123") || strings.Contains(out, "hunter2") {
+		t.Fatalf("log leaked secrets: %s", out)
+	}
+	for _, want := range []string{"method=POST", "method=GET", "path=/v1/kv/data/apps/demo", "status=204", "status=200"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in log output: %s", want, out)
+		}
+	}
+}
+
+func TestWithLoggerRecordsRetryReason(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"k":"v"}}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := New(server.URL, "token-123", WithLogger(logger), WithRetries(1, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	if _, err := client.ReadKVv2(context.Background(), "kv", "apps/demo"); err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "retry scheduled") || !strings.Contains(out, "reason=status:503") {
+		t.Fatalf("expected retry reason in log output: %s", out)
+	}
+}
+
+func TestWithHTTPClientLeavesCallerClientUnchanged(t *testing.T) {
+	t.Parallel()
+
+	transport := &http.Transport{}
+	shared := &http.Client{Transport: transport}
+	for range 2 {
+		_, err := New("http://vault.example", "token-123",
+			WithHTTPClient(shared),
+			WithTimeout(5*time.Second),
+			WithResponseHeaderTimeout(time.Second),
+			WithMaxResponseHeaderBytes(4096),
+			WithTraceContextPropagation(),
+		)
+		if err != nil {
+			t.Fatalf("new client: %v", err)
+		}
+	}
+
+	if shared.Transport != transport || shared.Timeout != 0 || shared.CheckRedirect != nil {
+		t.Fatalf("caller's client was modified: %#v", shared)
+	}
+	if transport.ResponseHeaderTimeout != 0 || transport.MaxResponseHeaderBytes != 0 {
+		t.Fatalf("caller's transport was modified: %#v", transport)
+	}
+}
@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// DeleteKVv2 soft-deletes the latest version of a KV v2 secret. The version
+// can be restored with UndeleteKVv2Versions.
+func (c *Client) DeleteKVv2(ctx context.Context, secretsEngine string, secretPath string) error {
+	path, err := kvV2Path(secretsEngine, "data", secretPath)
+	if err != nil {
+		return err
+	}
+	return c.kvV2Lifecycle(ctx, "delete", http.MethodDelete, path, secretPath, nil)
+}
+
+// DeleteKVv2Versions soft-deletes specific versions of a KV v2 secret.
+func (c *Client) DeleteKVv2Versions(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	versions []int,
+) error {
+	return c.kvV2VersionLifecycle(ctx, "delete", secretsEngine, secretPath, versions)
+}
+
+// UndeleteKVv2Versions restores soft-deleted versions of a KV v2 secret.
+func (c *Client) UndeleteKVv2Versions(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	versions []int,
+) error {
+	return c.kvV2VersionLifecycle(ctx, "undelete", secretsEngine, secretPath, versions)
+}
+
+// DestroyKVv2Versions permanently removes the data of specific versions of a
+// KV v2 secret. Destroyed versions cannot be restored.
+func (c *Client) DestroyKVv2Versions(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	versions []int,
+) error {
+	return c.kvV2VersionLifecycle(ctx, "destroy", secretsEngine, secretPath, versions)
+}
+
+// kvV2VersionLifecycle posts versions to the KV v2 segment of the same name.
+func (c *Client) kvV2VersionLifecycle(
+	ctx context.Context,
+	segment string,
+	secretsEngine string,
+	secretPath string,
+	versions []int,
+) error {
+	path, err := kvV2Path(secretsEngine, segment, secretPath)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return errors.New("secret versions must not be empty")
+	}
+	for _, version := range versions {
+		if version <= 0 {
+			return fmt.Errorf("secret version must be positive: %d", version)
+		}
+	}
+
+	payload := map[string]any{"versions": versions}
+	return c.kvV2Lifecycle(ctx, segment, http.MethodPost, path, secretPath, payload)
+}
+
+func (c *Client) kvV2Lifecycle(
+	ctx context.Context,
+	operation string,
+	method string,
+	path string,
+	secretPath string,
+	payload any,
+) error {
+	status, err := c.doJSON(ctx, operation, method, path, payload, nil)
+	if status == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+	return err
+}
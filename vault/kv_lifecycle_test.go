@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestKVv2Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	type call struct {
+		method   string
+		path     string
+		versions []int
+	}
+	var (
+		mu    sync.Mutex
+		calls []call
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/secret/data/missing" || r.URL.Path == "/v1/secret/destroy/missing" {
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+			return
+		}
+		recorded := call{method: r.Method, path: r.URL.Path}
+		if r.Method == http.MethodPost {
+			var payload struct {
+				Versions []int `json:"versions"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			recorded.versions = payload.Versions
+		}
+		mu.Lock()
+		calls = append(calls, recorded)
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.DeleteKVv2(ctx, "secret", "team/app"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if err := client.DeleteKVv2Versions(ctx, "secret", "team/app", []int{1, 2}); err != nil {
+		t.Fatalf("delete versions: %v", err)
+	}
+	if err := client.UndeleteKVv2Versions(ctx, "secret", "team/app", []int{2}); err != nil {
+		t.Fatalf("undelete versions: %v", err)
+	}
+	if err := client.DestroyKVv2Versions(ctx, "secret", "team/app", []int{1}); err != nil {
+		t.Fatalf("destroy versions: %v", err)
+	}
+
+	want := []call{
+		{method: http.MethodDelete, path: "/v1/secret/data/team/app"},
+		{method: http.MethodPost, path: "/v1/secret/delete/team/app", versions: []int{1, 2}},
+		{method: http.MethodPost, path: "/v1/secret/undelete/team/app", versions: []int{2}},
+		{method: http.MethodPost, path: "/v1/secret/destroy/team/app", versions: []int{1}},
+	}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("unexpected calls: %#v", calls)
+	}
+
+	if err := client.DeleteKVv2(ctx, "secret", "missing"); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound from delete, got %v", err)
+	}
+	if err := client.DestroyKVv2Versions(ctx, "secret", "missing", []int{1}); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound from destroy, got %v", err)
+	}
+}
+
+func TestKVv2LifecycleValidation(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://vault.example.com", "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := client.DeleteKVv2Versions(ctx, "secret", "team/app", nil); err == nil {
+		t.Fatalf("expected empty versions error")
+	}
+	if err := client.UndeleteKVv2Versions(ctx, "secret", "team/app", []int{0}); err == nil {
+		t.Fatalf("expected non-positive version error")
+	}
+	if err := client.DestroyKVv2Versions(ctx, "", "team/app", []int{1}); err == nil {
+		t.Fatalf("expected empty secrets engine error")
+	}
+}
@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ListKVv2 returns the keys directly under a KV v2 path, or under the mount
+// root when secretPath is empty. Keys ending in "/" are subfolders, returned
+// as-is so callers can recurse. A missing path returns ErrSecretNotFound and
+// an empty folder an empty slice.
+func (c *Client) ListKVv2(ctx context.Context, secretsEngine string, secretPath string) ([]string, error) {
+	mount, err := trimPathSegment(secretsEngine, "secrets engine")
+	if err != nil {
+		return nil, err
+	}
+	path := mount + "/metadata/"
+	if trimmed := strings.Trim(strings.TrimSpace(secretPath), "/"); trimmed != "" {
+		path += trimmed + "/"
+	}
+
+	var decoded struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+	status, err := c.doJSON(ctx, "list", http.MethodGet, path+"?list=true", nil, &decoded)
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Data.Keys == nil {
+		return []string{}, nil
+	}
+	return decoded.Data.Keys, nil
+}
@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestListKVv2(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Query().Get("list") != "true" {
+			http.Error(w, "expected list request", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/secret/metadata/teams/":
+			_, _ = w.Write([]byte(`{"data":{"keys":["db","payments/","web"]}}`))
+		case "/v1/secret/metadata/":
+			_, _ = w.Write([]byte(`{"data":{"keys":["teams/"]}}`))
+		case "/v1/secret/metadata/empty/":
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		default:
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	tests := []struct {
+		path    string
+		want    []string
+		wantErr error
+	}{
+		{path: "/teams/", want: []string{"db", "payments/", "web"}},
+		{path: "", want: []string{"teams/"}},
+		{path: "empty", want: []string{}},
+		{path: "missing", wantErr: ErrSecretNotFound},
+	}
+
+	for _, tt := range tests {
+		keys, err := client.ListKVv2(context.Background(), "secret", tt.path)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("path %q: expected %v, got %v", tt.path, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("path %q: list: %v", tt.path, err)
+		}
+		if !reflect.DeepEqual(keys, tt.want) {
+			t.Fatalf("path %q: unexpected keys %#v", tt.path, keys)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+// MergeKVv2 applies updates to a KV v2 secret with a shallow merge and
+// returns the merged data. The write uses check-and-set against the version
+// read, so concurrent changes are not clobbered; on a mismatch the merge is
+// redone once from a fresh read before ErrCASMismatch is returned. A missing
+// secret is created from updates alone; when the latest version is deleted or
+// destroyed, ErrSecretNotFound is returned rather than merging into nothing.
+func (c *Client) MergeKVv2(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	updates map[string]any,
+) (map[string]any, error) {
+	if len(updates) == 0 {
+		return nil, errors.New("secret updates must not be empty")
+	}
+
+	var err error
+	for range 2 {
+		var merged map[string]any
+		merged, err = c.mergeKVv2Once(ctx, secretsEngine, secretPath, updates)
+		if !errors.Is(err, ErrCASMismatch) {
+			return merged, err
+		}
+	}
+	return nil, err
+}
+
+func (c *Client) mergeKVv2Once(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	updates map[string]any,
+) (map[string]any, error) {
+	current, version, err := c.readKVv2At(ctx, secretsEngine, secretPath, 0)
+	if errors.Is(err, ErrSecretNotFound) && version == 0 {
+		// Version 0 makes Vault accept the write only if the secret still
+		// does not exist.
+		current, version, err = map[string]any{}, 0, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read secret to merge: %w", err)
+	}
+
+	merged := make(map[string]any, len(current)+len(updates))
+	maps.Copy(merged, current)
+	maps.Copy(merged, updates)
+	if err := c.writeKVv2(ctx, secretsEngine, secretPath, merged, version); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
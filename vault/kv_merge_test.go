@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// casServer is a KV v2 endpoint that enforces check-and-set. concurrentWrites
+// simulates other writers changing the secret right after each read.
+type casServer struct {
+	mu               sync.Mutex
+	data             map[string]any
+	version          int
+	concurrentWrites int
+}
+
+func (s *casServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		if s.version == 0 {
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"data": s.data, "metadata": map[string]any{"version": s.version}},
+		})
+		if s.concurrentWrites > 0 {
+			s.concurrentWrites--
+			s.version++
+		}
+	case http.MethodPost:
+		var payload struct {
+			Data    map[string]any `json:"data"`
+			Options struct {
+				CAS *int `json:"cas"`
+			} `json:"options"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Options.CAS == nil {
+			http.Error(w, "missing cas", http.StatusBadRequest)
+			return
+		}
+		if *payload.Options.CAS != s.version {
+			http.Error(w, `{"errors":["check-and-set parameter did not match the current version"]}`, http.StatusBadRequest)
+			return
+		}
+		s.data = payload.Data
+		s.version++
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestMergeKVv2(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		initial          map[string]any
+		concurrentWrites int
+		deleted          bool
+		want             map[string]any
+		wantErr          error
+	}{
+		{
+			name:    "merges into existing secret",
+			initial: map[string]any{"username": "app", "password": "old"},
+			want:    map[string]any{"username": "app", "password": "new", "api_key": "k"},
+		},
+		{
+			name: "creates missing secret",
+			want: map[string]any{"password": "new", "api_key": "k"},
+		},
+		{
+			name:             "retries once on cas mismatch",
+			initial:          map[string]any{"username": "app"},
+			concurrentWrites: 1,
+			want:             map[string]any{"username": "app", "password": "new", "api_key": "k"},
+		},
+		{
+			name:             "gives up after second cas mismatch",
+			initial:          map[string]any{"username": "app"},
+			concurrentWrites: 2,
+			wantErr:          ErrCASMismatch,
+		},
+		{
+			name:    "reports deleted latest version as not found",
+			deleted: true,
+			wantErr: ErrSecretNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			backend := &casServer{data: tt.initial, concurrentWrites: tt.concurrentWrites}
+			if tt.initial != nil || tt.deleted {
+				backend.version = 1
+			}
+			server := httptest.NewServer(backend)
+			defer server.Close()
+
+			client, err := New(server.URL, "token-123")
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			got, err := client.MergeKVv2(context.Background(), "kv", "apps/demo", map[string]any{"password": "new", "api_key": "k"})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if tt.deleted && backend.version != 1 {
+					t.Fatalf("expected no write over a deleted version, now at version %d", backend.version)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("merge: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(backend.data, tt.want) {
+				t.Fatalf("unexpected merge result %#v, stored %#v", got, backend.data)
+			}
+		})
+	}
+}
+
+func TestMergeKVv2RequiresUpdates(t *testing.T) {
+	t.Parallel()
+
+	client, err := New("https://vault.example.com", "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	if _, err := client.MergeKVv2(context.Background(), "kv", "apps/demo", nil); err == nil {
+		t.Fatalf("expected empty updates error")
+	}
+}
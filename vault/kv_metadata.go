@@ -0,0 +1,171 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetadataConfig sets KV v2 secret metadata. Zero values leave the
+// corresponding setting unchanged.
+type MetadataConfig struct {
+	MaxVersions        int
+	CASRequired        *bool
+	DeleteVersionAfter time.Duration
+	CustomMetadata     map[string]string
+}
+
+// KVMetadata is the metadata stored for a KV v2 secret.
+type KVMetadata struct {
+	MaxVersions        int
+	CASRequired        bool
+	DeleteVersionAfter time.Duration
+	CustomMetadata     map[string]string
+
+	CurrentVersion int
+	OldestVersion  int
+	CreatedTime    time.Time
+	UpdatedTime    time.Time
+	// Versions holds the state of each retained version, keyed by number.
+	Versions map[int]KVVersionMetadata
+}
+
+// KVVersionMetadata describes one version of a KV v2 secret. DeletionTime is
+// zero unless the version is soft-deleted or scheduled for deletion.
+type KVVersionMetadata struct {
+	CreatedTime  time.Time
+	DeletionTime time.Time
+	Destroyed    bool
+}
+
+// Deleted reports whether the version is soft-deleted at the given time.
+func (v KVVersionMetadata) Deleted(now time.Time) bool {
+	return !v.DeletionTime.IsZero() && !v.DeletionTime.After(now)
+}
+
+// WriteKVv2Metadata sets metadata such as version limits and custom tags on a KV v2 path.
+func (c *Client) WriteKVv2Metadata(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	cfg MetadataConfig,
+) error {
+	path, err := kvV2Path(secretsEngine, "metadata", secretPath)
+	if err != nil {
+		return err
+	}
+	if cfg.MaxVersions < 0 {
+		return errors.New("max versions must not be negative")
+	}
+	if cfg.DeleteVersionAfter < 0 {
+		return errors.New("delete version after must not be negative")
+	}
+
+	payload := map[string]any{}
+	if cfg.MaxVersions > 0 {
+		payload["max_versions"] = cfg.MaxVersions
+	}
+	if cfg.CASRequired != nil {
+		payload["cas_required"] = *cfg.CASRequired
+	}
+	if cfg.DeleteVersionAfter > 0 {
+		payload["delete_version_after"] = cfg.DeleteVersionAfter.String()
+	}
+	if cfg.CustomMetadata != nil {
+		payload["custom_metadata"] = cfg.CustomMetadata
+	}
+
+	_, err = c.doJSON(ctx, "metadata write", http.MethodPost, path, payload, nil)
+	return err
+}
+
+// ReadKVv2Metadata reads the metadata stored for a KV v2 path.
+func (c *Client) ReadKVv2Metadata(ctx context.Context, secretsEngine string, secretPath string) (*KVMetadata, error) {
+	path, err := kvV2Path(secretsEngine, "metadata", secretPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded struct {
+		Data *struct {
+			MaxVersions        int               `json:"max_versions"`
+			CASRequired        bool              `json:"cas_required"`
+			DeleteVersionAfter string            `json:"delete_version_after"`
+			CustomMetadata     map[string]string `json:"custom_metadata"`
+			CurrentVersion     int               `json:"current_version"`
+			OldestVersion      int               `json:"oldest_version"`
+			CreatedTime        string            `json:"created_time"`
+			UpdatedTime        string            `json:"updated_time"`
+			Versions           map[string]struct {
+				CreatedTime  string `json:"created_time"`
+				DeletionTime string `json:"deletion_time"`
+				Destroyed    bool   `json:"destroyed"`
+			} `json:"versions"`
+		} `json:"data"`
+	}
+	status, err := c.doJSON(ctx, "metadata read", http.MethodGet, path, nil, &decoded)
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Data == nil {
+		return nil, fmt.Errorf("vault response missing secret metadata at path: %s", secretPath)
+	}
+
+	metadata := &KVMetadata{
+		MaxVersions:    decoded.Data.MaxVersions,
+		CASRequired:    decoded.Data.CASRequired,
+		CustomMetadata: decoded.Data.CustomMetadata,
+		CurrentVersion: decoded.Data.CurrentVersion,
+		OldestVersion:  decoded.Data.OldestVersion,
+		Versions:       make(map[int]KVVersionMetadata, len(decoded.Data.Versions)),
+	}
+	if metadata.CreatedTime, err = parseVaultTime("created_time", decoded.Data.CreatedTime); err != nil {
+		return nil, err
+	}
+	if metadata.UpdatedTime, err = parseVaultTime("updated_time", decoded.Data.UpdatedTime); err != nil {
+		return nil, err
+	}
+	for key, raw := range decoded.Data.Versions {
+		number, convErr := strconv.Atoi(key)
+		if convErr != nil {
+			return nil, fmt.Errorf("decode vault secret version %q: %w", key, convErr)
+		}
+		version := KVVersionMetadata{Destroyed: raw.Destroyed}
+		if version.CreatedTime, err = parseVaultTime("created_time", raw.CreatedTime); err != nil {
+			return nil, err
+		}
+		if version.DeletionTime, err = parseVaultTime("deletion_time", raw.DeletionTime); err != nil {
+			return nil, err
+		}
+		metadata.Versions[number] = version
+	}
+	if raw := strings.TrimSpace(decoded.Data.DeleteVersionAfter); raw != "" {
+		deleteAfter, parseErr := time.ParseDuration(raw)
+		if parseErr != nil {
+			return nil, fmt.Errorf("decode vault delete_version_after %q: %w", raw, parseErr)
+		}
+		metadata.DeleteVersionAfter = deleteAfter
+	}
+
+	return metadata, nil
+}
+
+// parseVaultTime parses an RFC 3339 timestamp, treating an empty value as the
+// zero time.
+func parseVaultTime(field string, raw string) (time.Time, error) {
+	if strings.TrimSpace(raw) == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode vault %s %q: %w", field, raw, err)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWriteAndReadKVv2Metadata(t *testing.T) {
+	t.Parallel()
+
+	stored := map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/metadata/team/app" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPost:
+			if err := json.NewDecoder(r.Body).Decode(&stored); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": stored})
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	casRequired := true
+	err = client.WriteKVv2Metadata(context.Background(), "secret", "team/app", MetadataConfig{
+		MaxVersions:        5,
+		CASRequired:        &casRequired,
+		DeleteVersionAfter: 3 * time.Hour,
+		CustomMetadata:     map[string]string{"owner": "platform"},
+	})
+	if err != nil {
+		t.Fatalf("write metadata: %v", err)
+	}
+	if stored["delete_version_after"] != "3h0m0s" {
+		t.Fatalf("unexpected delete_version_after payload: %#v", stored["delete_version_after"])
+	}
+
+	metadata, err := client.ReadKVv2Metadata(context.Background(), "secret", "team/app")
+	if err != nil {
+		t.Fatalf("read metadata: %v", err)
+	}
+	if metadata.MaxVersions != 5 || !metadata.CASRequired || metadata.DeleteVersionAfter != 3*time.Hour {
+		t.Fatalf("unexpected metadata: %#v", metadata)
+	}
+	if metadata.CustomMetadata["owner"] != "platform" {
+		t.Fatalf("unexpected custom metadata: %#v", metadata.CustomMetadata)
+	}
+
+	_, err = client.ReadKVv2Metadata(context.Background(), "secret", "missing")
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound, got: %v", err)
+	}
+}
+
+func TestReadKVv2MetadataVersions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{
+			"current_version":3,
+			"oldest_version":1,
+			"max_versions":0,
+			"created_time":"2026-01-01T10:00:00.123456Z",
+			"updated_time":"2026-01-03T10:00:00Z",
+			"versions":{
+				"1":{"created_time":"2026-01-01T10:00:00Z","deletion_time":"","destroyed":true},
+				"2":{"created_time":"2026-01-02T10:00:00Z","deletion_time":"2026-01-02T12:00:00Z","destroyed":false},
+				"3":{"created_time":"2026-01-03T10:00:00Z","deletion_time":"","destroyed":false}
+			}
+		}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	metadata, err := client.ReadKVv2Metadata(context.Background(), "secret", "team/app")
+	if err != nil {
+		t.Fatalf("read metadata: %v", err)
+	}
+	if metadata.CurrentVersion != 3 || metadata.OldestVersion != 1 || len(metadata.Versions) != 3 {
+		t.Fatalf("unexpected metadata: %#v", metadata)
+	}
+	if want := time.Date(2026, 1, 3, 10, 0, 0, 0, time.UTC); !metadata.UpdatedTime.Equal(want) {
+		t.Fatalf("unexpected updated time: %s", metadata.UpdatedTime)
+	}
+
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	if !metadata.Versions[1].Destroyed || metadata.Versions[1].Deleted(now) {
+		t.Fatalf("unexpected version 1 state: %#v", metadata.Versions[1])
+	}
+	if !metadata.Versions[2].Deleted(now) || metadata.Versions[2].Destroyed {
+		t.Fatalf("unexpected version 2 state: %#v", metadata.Versions[2])
+	}
+	if metadata.Versions[3].Deleted(now) || metadata.Versions[3].CreatedTime.IsZero() {
+		t.Fatalf("unexpected version 3 state: %#v", metadata.Versions[3])
+	}
+}
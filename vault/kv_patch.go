@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// PatchKVv2 merges partial into the latest version of a KV v2 secret on the
+// server, creating a new version without a read-modify-write round trip. Keys
+// set to nil are removed. A path that has never been written is reported as
+// ErrSecretNotFound.
+func (c *Client) PatchKVv2(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	partial map[string]any,
+) error {
+	if len(partial) == 0 {
+		return errors.New("secret patch must not be empty")
+	}
+	vaultURL, err := c.kvV2URL(secretsEngine, secretPath)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(map[string]any{"data": partial})
+	if err != nil {
+		return fmt.Errorf("marshal vault patch payload: %w", err)
+	}
+
+	statusCode, responseBody, err := c.send(ctx, "patch", http.MethodPatch, vaultURL, body)
+	if err != nil {
+		return err
+	}
+	if statusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+	if statusCode < 200 || statusCode >= 300 {
+		return statusError("patch", statusCode, responseBody)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPatchKVv2(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", r.Method)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/merge-patch+json" {
+			t.Fatalf("unexpected content type: %s", got)
+		}
+		if r.URL.Path != "/v1/secret/data/team/app" {
+			http.Error(w, `{"errors":[]}`, http.StatusNotFound)
+			return
+		}
+
+		var payload struct {
+			Data map[string]any `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode request body: %v", err)
+		}
+		if payload.Data["password"] != "new" || len(payload.Data) != 1 {
+			t.Fatalf("unexpected patch payload: %#v", payload.Data)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"version": 3}})
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+	partial := map[string]any{"password": "new"}
+
+	tests := []struct {
+		name         string
+		path         string
+		partial      map[string]any
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{name: "patches existing secret", path: "team/app", partial: partial},
+		{name: "missing secret", path: "team/missing", partial: partial, wantNotFound: true},
+		{name: "empty patch", path: "team/app", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		err := client.PatchKVv2(ctx, "secret", tc.path, tc.partial)
+		switch {
+		case tc.wantNotFound:
+			if !errors.Is(err, ErrSecretNotFound) {
+				t.Fatalf("%s: expected ErrSecretNotFound, got: %v", tc.name, err)
+			}
+		case tc.wantErr:
+			if err == nil {
+				t.Fatalf("%s: expected error", tc.name)
+			}
+		case err != nil:
+			t.Fatalf("%s: patch: %v", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxStreamErrorBody bounds how much of an error response is read into the
+// returned error.
+const maxStreamErrorBody = 64 << 10
+
+// ReadKVv2Stream reads the latest version of a KV v2 secret without buffering
+// the response. handle receives a decoder positioned at the secret's data
+// object (data.data), so it can Decode it or walk it with Token. Streamed
+// reads are not retried.
+func (c *Client) ReadKVv2Stream(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	handle func(dec *json.Decoder) error,
+) error {
+	if handle == nil {
+		return errors.New("stream handler must not be nil")
+	}
+	vaultURL, err := c.kvV2URL(secretsEngine, secretPath)
+	if err != nil {
+		return err
+	}
+	if c.expiryWatch != nil {
+		c.expiryWatch.check(ctx, c)
+	}
+
+	c.stats.RecordRequest()
+	err = c.stream(ctx, vaultURL, secretPath, handle)
+	if err != nil {
+		c.stats.RecordFailure()
+	}
+	return err
+}
+
+func (c *Client) stream(
+	ctx context.Context,
+	vaultURL string,
+	secretPath string,
+	handle func(dec *json.Decoder) error,
+) error {
+	req, err := c.newRequest(ctx, "read", http.MethodGet, vaultURL, nil)
+	if err != nil {
+		return err
+	}
+	started := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logAttempt(ctx, http.MethodGet, vaultURL, 0, 0, started, err)
+		return fmt.Errorf("vault read request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	c.stats.RecordStatus(resp.StatusCode)
+	c.logAttempt(ctx, http.MethodGet, vaultURL, 0, resp.StatusCode, started, nil)
+	c.recordVaultIndex(resp.Header.Get(headerVaultIndex))
+
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %s", ErrSecretNotFound, secretPath)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxStreamErrorBody))
+		return statusError("read", resp.StatusCode, body)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for _, key := range []string{"data", "data"} {
+		found, err := seekObjectKey(dec, key)
+		if err != nil {
+			return fmt.Errorf("decode vault read response: %w", err)
+		}
+		if !found {
+			return fmt.Errorf("vault response missing secret data at path: %s", secretPath)
+		}
+	}
+	// The secret must be an object; a null data field marks a deleted version.
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("decode vault read response: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("vault response missing secret data at path: %s", secretPath)
+	}
+	// Hand over a fresh decoder that replays the consumed brace, so handle sees
+	// the whole object while the rest of the body is still streamed.
+	rest := io.MultiReader(strings.NewReader("{"), dec.Buffered(), resp.Body)
+	return handle(json.NewDecoder(rest))
+}
+
+// seekObjectKey consumes the opening brace of an object and advances dec past
+// members until key, leaving dec positioned at that member's value. It
+// reports false when the value read is not an object or has no such key.
+func seekObjectKey(dec *json.Decoder, key string) (bool, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return false, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return false, nil
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return false, err
+		}
+		if name, _ := tok.(string); name == key {
+			return true, nil
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadKVv2Stream(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/secret/data/big":
+			_, _ = w.Write([]byte(`{"request_id":"r1","lease_id":"","data":{"metadata":{"version":2},"data":{"a":"1","b":{"nested":true}}},"warnings":null}`))
+		case "/v1/secret/data/null":
+			_, _ = w.Write([]byte(`{"data":{"data":null,"metadata":{"deletion_time":"2026-01-01T00:00:00Z"}}}`))
+		case "/v1/secret/data/broken":
+			http.Error(w, `{"errors":["permission denied"]}`, http.StatusForbidden)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	ctx := context.Background()
+
+	var keys []string
+	err = client.ReadKVv2Stream(ctx, "secret", "big", func(dec *json.Decoder) error {
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			keys = append(keys, tok.(string))
+			var value json.RawMessage
+			if err := dec.Decode(&value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+	if len(keys) != 2 || keys[0] != "a" || keys[1] != "b" {
+		t.Fatalf("unexpected streamed keys: %v", keys)
+	}
+
+	noop := func(*json.Decoder) error { return nil }
+	if err := client.ReadKVv2Stream(ctx, "secret", "missing", noop); !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound, got %v", err)
+	}
+	if err := client.ReadKVv2Stream(ctx, "secret", "null", noop); err == nil {
+		t.Fatalf("expected missing secret data error")
+	}
+	if err := client.ReadKVv2Stream(ctx, "secret", "broken", noop); err == nil || errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected status error, got %v", err)
+	}
+	if err := client.ReadKVv2Stream(ctx, "secret", "big", nil); err == nil {
+		t.Fatalf("expected nil handler validation error")
+	}
+}
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const maxConcurrentVersionReads = 8
+
+// ReadKVv2Versions reads several versions of a KV v2 secret concurrently and
+// returns them keyed by version number. Versions that fail to load, including
+// missing or destroyed ones (ErrSecretNotFound), are omitted from the result
+// and reported in the joined error.
+func (c *Client) ReadKVv2Versions(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	versions []int,
+) (map[int]map[string]any, error) {
+	if len(versions) == 0 {
+		return nil, errors.New("secret versions must not be empty")
+	}
+	for _, version := range versions {
+		if version <= 0 {
+			return nil, fmt.Errorf("secret version must be positive: %d", version)
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		errs    []error
+		results = make(map[int]map[string]any, len(versions))
+		seen    = make(map[int]struct{}, len(versions))
+		sem     = make(chan struct{}, maxConcurrentVersionReads)
+	)
+
+	for _, version := range versions {
+		if _, ok := seen[version]; ok {
+			continue
+		}
+		seen[version] = struct{}{}
+
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(version int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			data, err := c.ReadKVv2Version(ctx, secretsEngine, secretPath, version)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("version %d: %w", version, err))
+				return
+			}
+			results[version] = data
+		}(version)
+	}
+	wg.Wait()
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadKVv2Versions(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/team/app" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		version := r.URL.Query().Get("version")
+		if version == "3" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"data": map[string]any{"password": "pass-v" + version},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	got, err := client.ReadKVv2Versions(context.Background(), "secret", "team/app", []int{1, 2, 3, 2})
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("expected ErrSecretNotFound for version 3, got: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 versions, got: %#v", got)
+	}
+	if got[1]["password"] != "pass-v1" || got[2]["password"] != "pass-v2" {
+		t.Fatalf("unexpected version data: %#v", got)
+	}
+
+	if _, err := client.ReadKVv2Versions(context.Background(), "secret", "team/app", []int{0}); err == nil {
+		t.Fatalf("expected invalid version validation error")
+	}
+}
@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// KVv2WriteOptions controls a KV v2 write made with WriteKVv2WithOptions.
+type KVv2WriteOptions struct {
+	// CAS, when set, makes the write succeed only if the secret's current
+	// version matches, with 0 meaning the path must not exist yet. A rejected
+	// write is reported as ErrCASMismatch. As with WriteKVv2CAS, a dropped
+	// connection is not retried.
+	CAS *int
+	// SetPathRetention, when positive, sets the path's delete_version_after
+	// metadata once the write succeeds. It is a path-wide setting: Vault
+	// soft-deletes versions created after it is set, including ones written
+	// later without this option, once the duration has passed.
+	SetPathRetention time.Duration
+}
+
+// WriteKVv2WithOptions writes secret data to a KV v2 path with a
+// check-and-set version and an optional path retention setting. Retention is
+// only changed after the data write succeeds, so a rejected check-and-set
+// leaves the path untouched.
+func (c *Client) WriteKVv2WithOptions(
+	ctx context.Context,
+	secretsEngine string,
+	secretPath string,
+	credentials map[string]any,
+	opts KVv2WriteOptions,
+) error {
+	cas := -1
+	if opts.CAS != nil {
+		if *opts.CAS < 0 {
+			return errors.New("expected secret version must not be negative")
+		}
+		cas = *opts.CAS
+	}
+	if opts.SetPathRetention < 0 {
+		return errors.New("path retention must be positive")
+	}
+
+	if err := c.writeKVv2(ctx, secretsEngine, secretPath, credentials, cas); err != nil {
+		return err
+	}
+	if opts.SetPathRetention > 0 {
+		err := c.WriteKVv2Metadata(ctx, secretsEngine, secretPath, MetadataConfig{
+			DeleteVersionAfter: opts.SetPathRetention,
+		})
+		if err != nil {
+			return fmt.Errorf("secret written but path retention not applied: %w", err)
+		}
+	}
+	return nil
+}
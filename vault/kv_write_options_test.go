@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWriteKVv2WithOptions(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name         string
+		opts         KVv2WriteOptions
+		wantRequests []string
+		wantCAS      *int
+		wantTTL      string
+		wantErr      bool
+	}{
+		{
+			name:         "plain write",
+			wantRequests: []string{"/v1/secret/data/team/app"},
+		},
+		{
+			name:         "cas and ttl",
+			opts:         KVv2WriteOptions{CAS: ptr(2), SetPathRetention: 30 * time.Minute},
+			wantRequests: []string{"/v1/secret/data/team/app", "/v1/secret/metadata/team/app"},
+			wantCAS:      ptr(2),
+			wantTTL:      "30m0s",
+		},
+		{
+			name:    "negative ttl",
+			opts:    KVv2WriteOptions{SetPathRetention: -time.Second},
+			wantErr: true,
+		},
+		{
+			name:    "negative cas",
+			opts:    KVv2WriteOptions{CAS: ptr(-1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu       sync.Mutex
+				requests []string
+				cas      *int
+				ttl      string
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload struct {
+					Data               map[string]any `json:"data"`
+					DeleteVersionAfter string         `json:"delete_version_after"`
+					Options            struct {
+						CAS *int `json:"cas"`
+					} `json:"options"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Errorf("decode request body: %v", err)
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				requests = append(requests, r.URL.Path)
+				if payload.DeleteVersionAfter != "" {
+					ttl = payload.DeleteVersionAfter
+				}
+				if payload.Data != nil {
+					cas = payload.Options.CAS
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, err := New(server.URL, "token-123")
+			if err != nil {
+				t.Fatalf("new client: %v", err)
+			}
+
+			err = client.WriteKVv2WithOptions(context.Background(), "secret", "team/app",
+				map[string]any{"password": "p"}, tc.opts)
+			if tc.wantErr {
+				if err == nil || len(requests) != 0 {
+					t.Fatalf("expected validation error before any request, got %v after %v", err, requests)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("write with options: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(requests) != len(tc.wantRequests) {
+				t.Fatalf("unexpected requests: %v", requests)
+			}
+			for i, path := range tc.wantRequests {
+				if requests[i] != path {
+					t.Fatalf("unexpected requests: %v", requests)
+				}
+			}
+			if (cas == nil) != (tc.wantCAS == nil) || (cas != nil && *cas != *tc.wantCAS) {
+				t.Fatalf("unexpected cas option: %v", cas)
+			}
+			if ttl != tc.wantTTL {
+				t.Fatalf("unexpected delete_version_after: %q", ttl)
+			}
+		})
+	}
+}
+
+func TestWriteKVv2WithOptionsCASMismatchKeepsRetention(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+	cas := &casServer{data: map[string]any{"password": "old"}, version: 3}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		cas.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	stale := 1
+	err = client.WriteKVv2WithOptions(context.Background(), "secret", "team/app",
+		map[string]any{"password": "new"}, KVv2WriteOptions{CAS: &stale, SetPathRetention: time.Hour})
+	if !errors.Is(err, ErrCASMismatch) {
+		t.Fatalf("expected ErrCASMismatch, got: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(paths) != 1 || paths[0] != "/v1/secret/data/team/app" {
+		t.Fatalf("expected only the rejected data write, got requests: %v", paths)
+	}
+}
@@ -0,0 +1,112 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// PKIService provides Vault PKI secrets engine operations.
+type PKIService struct {
+	client *Client
+}
+
+// PKI returns the PKI service API.
+func (c *Client) PKI() *PKIService {
+	return &PKIService{client: c}
+}
+
+// PKIIssueRequest describes a certificate issued from a PKI role.
+type PKIIssueRequest struct {
+	CommonName string
+	AltNames   []string
+	IPSANs     []string
+	TTL        time.Duration
+}
+
+// PKICertificate is a certificate issued by the Vault PKI engine.
+type PKICertificate struct {
+	Certificate    string   `json:"certificate"`
+	IssuingCA      string   `json:"issuing_ca"`
+	CAChain        []string `json:"ca_chain"`
+	PrivateKey     string   `json:"private_key"`
+	PrivateKeyType string   `json:"private_key_type"`
+	SerialNumber   string   `json:"serial_number"`
+	Expiration     int64    `json:"expiration"`
+}
+
+// IssueCertificate issues a new certificate and private key from a PKI role.
+func (p *PKIService) IssueCertificate(
+	ctx context.Context,
+	mount string,
+	role string,
+	req PKIIssueRequest,
+) (*PKICertificate, error) {
+	cleanMount, err := trimPathSegment(mount, "pki mount")
+	if err != nil {
+		return nil, err
+	}
+	cleanRole, err := trimPathSegment(role, "pki role")
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.CommonName) == "" {
+		return nil, errors.New("pki common name must not be empty")
+	}
+
+	payload := map[string]any{"common_name": strings.TrimSpace(req.CommonName)}
+	if len(req.AltNames) > 0 {
+		payload["alt_names"] = strings.Join(req.AltNames, ",")
+	}
+	if len(req.IPSANs) > 0 {
+		payload["ip_sans"] = strings.Join(req.IPSANs, ",")
+	}
+	if req.TTL > 0 {
+		payload["ttl"] = fmt.Sprintf("%ds", int64(req.TTL.Seconds()))
+	}
+
+	var decoded struct {
+		Data *PKICertificate `json:"data"`
+	}
+	_, err = p.client.doJSON(
+		ctx,
+		"pki issue",
+		http.MethodPost,
+		fmt.Sprintf("%s/issue/%s", cleanMount, cleanRole),
+		payload,
+		&decoded,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if decoded.Data == nil || decoded.Data.Certificate == "" {
+		return nil, fmt.Errorf("vault pki issue response missing certificate for role: %s", cleanRole)
+	}
+
+	return decoded.Data, nil
+}
+
+// RevokeCertificate revokes a certificate by serial number.
+func (p *PKIService) RevokeCertificate(ctx context.Context, mount string, serial string) error {
+	cleanMount, err := trimPathSegment(mount, "pki mount")
+	if err != nil {
+		return err
+	}
+	cleanSerial := strings.TrimSpace(serial)
+	if cleanSerial == "" {
+		return errors.New("pki serial number must not be empty")
+	}
+
+	_, err = p.client.doJSON(
+		ctx,
+		"pki revoke",
+		http.MethodPost,
+		cleanMount+"/revoke",
+		map[string]any{"serial_number": cleanSerial},
+		nil,
+	)
+	return err
+}
@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPKIIssueAndRevokeCertificate(t *testing.T) {
+	t.Parallel()
+
+	var revoked string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v1/pki/issue/web":
+			if payload["common_name"] != "app.acme.com" || payload["alt_names"] != "a.acme.com,b.acme.com" {
+				http.Error(w, "unexpected payload", http.StatusBadRequest)
+				return
+			}
+			if payload["ttl"] != "3600s" {
+				http.Error(w, "unexpected ttl", http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"certificate":   "-----BEGIN CERTIFICATE-----",
+					"issuing_ca":    "-----BEGIN CA-----",
+					"ca_chain":      []string{"-----BEGIN CA-----"},
+					"private_key":   "-----BEGIN KEY-----",
+					"serial_number": "aa:bb",
+				},
+			})
+		case "/v1/pki/revoke":
+			revoked, _ = payload["serial_number"].(string)
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	cert, err := client.PKI().IssueCertificate(context.Background(), "pki", "web", PKIIssueRequest{
+		CommonName: "app.acme.com",
+		AltNames:   []string{"a.acme.com", "b.acme.com"},
+		TTL:        time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("issue certificate: %v", err)
+	}
+	if cert.SerialNumber != "aa:bb" || len(cert.CAChain) != 1 || cert.PrivateKey == "" {
+		t.Fatalf("unexpected certificate: %#v", cert)
+	}
+
+	if err := client.PKI().RevokeCertificate(context.Background(), "pki", cert.SerialNumber); err != nil {
+		t.Fatalf("revoke certificate: %v", err)
+	}
+	if revoked != "aa:bb" {
+		t.Fatalf("unexpected revoked serial: %q", revoked)
+	}
+}
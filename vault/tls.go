@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/d-padmanabhan/platform-core-go/internal/httpx"
+)
+
+const defaultCertReloadInterval = 5 * time.Minute
+
+// certReloader serves a client certificate and reloads it when files change on disk.
+type certReloader struct {
+	certFile string
+	keyFile  string
+	interval time.Duration
+	now      func() time.Time
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	lastCheck time.Time
+}
+
+func newCertReloader(certFile string, keyFile string, interval time.Duration) (*certReloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("vault TLS certificate and key files must both be provided")
+	}
+	if interval <= 0 {
+		interval = defaultCertReloadInterval
+	}
+
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		interval: interval,
+		now:      time.Now,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetClientCertificate implements tls.Config.GetClientCertificate.
+func (r *certReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.now().Sub(r.lastCheck) >= r.interval {
+		modTime, err := r.latestModTime()
+		if err == nil && modTime.After(r.modTime) {
+			// Keep serving the previous certificate if a rotation is only half written.
+			_ = r.loadLocked()
+		}
+		r.lastCheck = r.now()
+	}
+
+	return r.cert, nil
+}
+
+func (r *certReloader) load() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.loadLocked()
+}
+
+func (r *certReloader) loadLocked() error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load vault client certificate: %w", err)
+	}
+
+	r.cert = &cert
+	r.modTime = modTime
+	r.lastCheck = r.now()
+	return nil
+}
+
+func (r *certReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("stat vault client certificate file: %w", err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func applyClientCertificate(client *http.Client, reloader *certReloader) error {
+	transport, err := httpx.CloneTransport(client)
+	if err != nil {
+		return fmt.Errorf("vault TLS certificate reload: %w", err)
+	}
+
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.GetClientCertificate = reloader.GetClientCertificate
+	client.Transport = transport
+	return nil
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCertReloaderReloadsChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	writeTestKeyPair(t, certFile, keyFile, "first", time.Now().Add(-time.Hour))
+
+	reloader, err := newCertReloader(certFile, keyFile, time.Minute)
+	if err != nil {
+		t.Fatalf("new cert reloader: %v", err)
+	}
+
+	now := time.Now()
+	reloader.now = func() time.Time { return now }
+
+	first, err := reloader.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatalf("get client certificate: %v", err)
+	}
+
+	writeTestKeyPair(t, certFile, keyFile, "second", time.Now())
+
+	cached, err := reloader.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatalf("get client certificate: %v", err)
+	}
+	if !bytes.Equal(cached.Certificate[0], first.Certificate[0]) {
+		t.Fatalf("expected cached certificate before reload interval elapsed")
+	}
+
+	now = now.Add(2 * time.Minute)
+	reloaded, err := reloader.GetClientCertificate(nil)
+	if err != nil {
+		t.Fatalf("get client certificate: %v", err)
+	}
+	if bytes.Equal(reloaded.Certificate[0], first.Certificate[0]) {
+		t.Fatalf("expected reloaded certificate after files changed")
+	}
+}
+
+func TestNewWithReloadingTLSCert(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	writeTestKeyPair(t, certFile, keyFile, "client", time.Now())
+
+	client, err := New("https://vault.example.com", "token-123", WithReloadingTLSCert(certFile, keyFile, time.Minute))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("unexpected transport type: %T", client.httpClient.Transport)
+	}
+	if transport.TLSClientConfig == nil || transport.TLSClientConfig.GetClientCertificate == nil {
+		t.Fatalf("expected GetClientCertificate to be configured")
+	}
+
+	_, err = New("https://vault.example.com", "token-123", WithReloadingTLSCert(certFile, "", time.Minute))
+	if err == nil {
+		t.Fatalf("expected missing key file validation error")
+	}
+}
+
+func writeTestKeyPair(t *testing.T, certFile string, keyFile string, commonName string, modTime time.Time) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+		t.Fatalf("write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+	for _, name := range []string{certFile, keyFile} {
+		if err := os.Chtimes(name, modTime, modTime); err != nil {
+			t.Fatalf("set file times: %v", err)
+		}
+	}
+}
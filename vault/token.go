@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const operationTokenLookup = "token lookup"
+
+// TokenInfo describes the token the client authenticates with.
+type TokenInfo struct {
+	Accessor    string
+	DisplayName string
+	Policies    []string
+	Renewable   bool
+	// TTL is the remaining lifetime at lookup time; zero means the token does not expire.
+	TTL time.Duration
+}
+
+// LookupSelf returns information about the client's own token.
+func (c *Client) LookupSelf(ctx context.Context) (*TokenInfo, error) {
+	var decoded struct {
+		Data *struct {
+			Accessor    string   `json:"accessor"`
+			DisplayName string   `json:"display_name"`
+			Policies    []string `json:"policies"`
+			Renewable   bool     `json:"renewable"`
+			TTL         int64    `json:"ttl"`
+		} `json:"data"`
+	}
+	if _, err := c.doJSON(ctx, operationTokenLookup, http.MethodGet, "auth/token/lookup-self", nil, &decoded); err != nil {
+		return nil, err
+	}
+	if decoded.Data == nil {
+		return nil, errors.New("vault token lookup response missing data")
+	}
+
+	return &TokenInfo{
+		Accessor:    decoded.Data.Accessor,
+		DisplayName: decoded.Data.DisplayName,
+		Policies:    decoded.Data.Policies,
+		Renewable:   decoded.Data.Renewable,
+		TTL:         time.Duration(decoded.Data.TTL) * time.Second,
+	}, nil
+}
+
+// TokenTTL returns the remaining lifetime of the client's token. Zero means the
+// token does not expire.
+func (c *Client) TokenTTL(ctx context.Context) (time.Duration, error) {
+	info, err := c.LookupSelf(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.TTL, nil
+}
+
+// tokenExpiryWatch warns once when requests are made with a token whose
+// remaining TTL is below the configured threshold. The expiry is looked up on
+// the first request and tracked locally afterwards.
+type tokenExpiryWatch struct {
+	threshold time.Duration
+	warn      func(remaining time.Duration)
+	now       func() time.Time
+
+	mu       sync.Mutex
+	looked   bool
+	expireAt time.Time
+	warned   bool
+}
+
+func (w *tokenExpiryWatch) check(ctx context.Context, c *Client) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.warned {
+		return
+	}
+	if !w.looked {
+		// A failed lookup is not retried so token warnings never add load or
+		// fail the request being made.
+		w.looked = true
+		info, err := c.LookupSelf(ctx)
+		if err != nil || info.TTL <= 0 {
+			return
+		}
+		w.expireAt = w.now().Add(info.TTL)
+	}
+	if w.expireAt.IsZero() {
+		return
+	}
+
+	if remaining := w.expireAt.Sub(w.now()); remaining < w.threshold {
+		w.warned = true
+		w.warn(max(remaining, 0))
+	}
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTokenTTL(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/auth/token/lookup-self" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"data":{"accessor":"acc-1","policies":["default"],"renewable":true,"ttl":3600}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123", WithTimeout(5*time.Second))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ttl, err := client.TokenTTL(context.Background())
+	if err != nil {
+		t.Fatalf("token ttl: %v", err)
+	}
+	if ttl != time.Hour {
+		t.Fatalf("unexpected ttl: %s", ttl)
+	}
+}
+
+func TestWithTokenExpiryWarningWarnsOnce(t *testing.T) {
+	t.Parallel()
+
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/auth/token/lookup-self" {
+			lookups.Add(1)
+			_, _ = w.Write([]byte(`{"data":{"ttl":120}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"username":"app"}}}`))
+	}))
+	defer server.Close()
+
+	var warnings []time.Duration
+	client, err := New(
+		server.URL,
+		"token-123",
+		WithTimeout(5*time.Second),
+		WithTokenExpiryWarning(5*time.Minute, func(remaining time.Duration) {
+			warnings = append(warnings, remaining)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	for range 3 {
+		if _, err := client.ReadKVv2(context.Background(), "kv", "apps/demo"); err != nil {
+			t.Fatalf("read: %v", err)
+		}
+	}
+	if len(warnings) != 1 || warnings[0] > 2*time.Minute {
+		t.Fatalf("expected a single warning under 2m, got %v", warnings)
+	}
+	if lookups.Load() != 1 {
+		t.Fatalf("expected a single token lookup, got %d", lookups.Load())
+	}
+}
+
+func TestTokenExpiryWatchWarnsWhenThresholdCrossed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	var warned int
+	watch := &tokenExpiryWatch{
+		threshold: time.Minute,
+		warn:      func(time.Duration) { warned++ },
+		now:       func() time.Time { return now },
+		looked:    true,
+		expireAt:  now.Add(10 * time.Minute),
+	}
+
+	watch.check(context.Background(), nil)
+	if warned != 0 {
+		t.Fatalf("expected no warning above threshold")
+	}
+
+	now = now.Add(9*time.Minute + 30*time.Second)
+	watch.check(context.Background(), nil)
+	watch.check(context.Background(), nil)
+	if warned != 1 {
+		t.Fatalf("expected exactly one warning, got %d", warned)
+	}
+}
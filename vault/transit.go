@@ -0,0 +1,158 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TransitService provides Vault Transit secrets engine operations.
+type TransitService struct {
+	client *Client
+}
+
+// Transit returns the Transit service API.
+func (c *Client) Transit() *TransitService {
+	return &TransitService{client: c}
+}
+
+// TransitBatchItemError reports a failure for a single batch_input item.
+type TransitBatchItemError struct {
+	Index   int
+	Message string
+}
+
+// Error implements the error interface.
+func (e *TransitBatchItemError) Error() string {
+	return fmt.Sprintf("vault transit batch item %d failed: %s", e.Index, e.Message)
+}
+
+type transitBatchResponse struct {
+	Data struct {
+		BatchResults []struct {
+			Ciphertext string `json:"ciphertext"`
+			Plaintext  string `json:"plaintext"`
+			Error      string `json:"error"`
+		} `json:"batch_results"`
+	} `json:"data"`
+}
+
+// EncryptBatch encrypts items with a named Transit key in a single request.
+// Ciphertexts are returned in input order; failed items are left empty and
+// reported as joined *TransitBatchItemError values.
+func (t *TransitService) EncryptBatch(
+	ctx context.Context,
+	mount string,
+	keyName string,
+	items [][]byte,
+) ([]string, error) {
+	batch := make([]map[string]string, len(items))
+	for i, item := range items {
+		batch[i] = map[string]string{"plaintext": base64.StdEncoding.EncodeToString(item)}
+	}
+
+	results, err := t.batch(ctx, "encrypt", mount, keyName, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]string, len(results.Data.BatchResults))
+	var itemErrs []error
+	for i, result := range results.Data.BatchResults {
+		if result.Error != "" {
+			itemErrs = append(itemErrs, &TransitBatchItemError{Index: i, Message: result.Error})
+			continue
+		}
+		out[i] = result.Ciphertext
+	}
+
+	return out, errors.Join(itemErrs...)
+}
+
+// DecryptBatch decrypts ciphertexts with a named Transit key in a single request.
+// Plaintexts are returned in input order; failed items are left nil and
+// reported as joined *TransitBatchItemError values.
+func (t *TransitService) DecryptBatch(
+	ctx context.Context,
+	mount string,
+	keyName string,
+	ciphertexts []string,
+) ([][]byte, error) {
+	batch := make([]map[string]string, len(ciphertexts))
+	for i, ciphertext := range ciphertexts {
+		batch[i] = map[string]string{"ciphertext": ciphertext}
+	}
+
+	results, err := t.batch(ctx, "decrypt", mount, keyName, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]byte, len(results.Data.BatchResults))
+	var itemErrs []error
+	for i, result := range results.Data.BatchResults {
+		if result.Error != "" {
+			itemErrs = append(itemErrs, &TransitBatchItemError{Index: i, Message: result.Error})
+			continue
+		}
+		plaintext, decodeErr := base64.StdEncoding.DecodeString(result.Plaintext)
+		if decodeErr != nil {
+			itemErrs = append(itemErrs, &TransitBatchItemError{Index: i, Message: decodeErr.Error()})
+			continue
+		}
+		out[i] = plaintext
+	}
+
+	return out, errors.Join(itemErrs...)
+}
+
+func (t *TransitService) batch(
+	ctx context.Context,
+	operation string,
+	mount string,
+	keyName string,
+	batch []map[string]string,
+) (*transitBatchResponse, error) {
+	cleanMount, err := trimPathSegment(mount, "transit mount")
+	if err != nil {
+		return nil, err
+	}
+	cleanKey, err := trimPathSegment(keyName, "transit key name")
+	if err != nil {
+		return nil, err
+	}
+	if len(batch) == 0 {
+		return nil, errors.New("transit batch must not be empty")
+	}
+
+	payload := map[string]any{
+		"batch_input": batch,
+		// Report partial failures per item instead of failing the whole request.
+		"partial_failure_response_code": http.StatusMultiStatus,
+	}
+
+	var results transitBatchResponse
+	_, err = t.client.doJSON(
+		ctx,
+		"transit "+operation,
+		http.MethodPost,
+		fmt.Sprintf("%s/%s/%s", cleanMount, operation, cleanKey),
+		payload,
+		&results,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Data.BatchResults) != len(batch) {
+		return nil, fmt.Errorf(
+			"vault transit %s returned %d results for %d inputs",
+			operation,
+			len(results.Data.BatchResults),
+			len(batch),
+		)
+	}
+
+	return &results, nil
+}
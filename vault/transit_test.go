@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransitEncryptAndDecryptBatch(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			BatchInput []map[string]string `json:"batch_input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		results := make([]map[string]string, 0, len(payload.BatchInput))
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/logs":
+			for _, item := range payload.BatchInput {
+				results = append(results, map[string]string{"ciphertext": "vault:v1:" + item["plaintext"]})
+			}
+		case "/v1/transit/decrypt/logs":
+			for _, item := range payload.BatchInput {
+				if item["ciphertext"] == "bad" {
+					results = append(results, map[string]string{"error": "invalid ciphertext"})
+					continue
+				}
+				results = append(results, map[string]string{"plaintext": item["ciphertext"][len("vault:v1:"):]})
+			}
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"batch_results": results},
+		})
+	}))
+	defer server.Close()
+
+	client, err := New(server.URL, "token-123")
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	ciphertexts, err := client.Transit().EncryptBatch(
+		context.Background(),
+		"transit",
+		"logs",
+		[][]byte{[]byte("alpha"), []byte("beta")},
+	)
+	if err != nil {
+		t.Fatalf("encrypt batch: %v", err)
+	}
+	if ciphertexts[1] != "vault:v1:"+base64.StdEncoding.EncodeToString([]byte("beta")) {
+		t.Fatalf("unexpected ciphertexts: %#v", ciphertexts)
+	}
+
+	plaintexts, err := client.Transit().DecryptBatch(
+		context.Background(),
+		"transit",
+		"logs",
+		[]string{ciphertexts[0], "bad", ciphertexts[1]},
+	)
+
+	var itemErr *TransitBatchItemError
+	if !errors.As(err, &itemErr) || itemErr.Index != 1 {
+		t.Fatalf("expected item error for index 1, got: %v", err)
+	}
+	if string(plaintexts[0]) != "alpha" || plaintexts[1] != nil || string(plaintexts[2]) != "beta" {
+		t.Fatalf("unexpected plaintexts: %q", plaintexts)
+	}
+}